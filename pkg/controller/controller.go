@@ -121,6 +121,15 @@ func DataVolumeKey(dataVolume *cdiv1.DataVolume) string {
 	return fmt.Sprintf("%v/%v", dataVolume.Namespace, dataVolume.Name)
 }
 
+// NetworkAttachmentDefinitionKey returns the key of the NetworkAttachmentDefinition referenced by a multus
+// network name, which may either be qualified by a namespace or be relative to the given namespace.
+func NetworkAttachmentDefinitionKey(namespace string, networkName string) string {
+	if strings.Contains(networkName, "/") {
+		return networkName
+	}
+	return fmt.Sprintf("%v/%v", namespace, networkName)
+}
+
 func VirtualMachineInstanceKeys(vmis []*v1.VirtualMachineInstance) []string {
 	keys := []string{}
 	for _, vmi := range vmis {
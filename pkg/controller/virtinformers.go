@@ -32,6 +32,7 @@ import (
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 
 	promv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	secv1 "github.com/openshift/api/security/v1"
@@ -186,6 +187,12 @@ type KubeInformerFactory interface {
 	// Watches for CDI DataSource objects
 	DataSource() cache.SharedIndexInformer
 
+	// Watches for NetworkAttachmentDefinition objects
+	NetworkAttachmentDefinition() cache.SharedIndexInformer
+
+	// Fake NetworkAttachmentDefinition informer used when the CRD is not installed
+	DummyNetworkAttachmentDefinition() cache.SharedIndexInformer
+
 	// Fake CDI DataSource informer used when feature gate is disabled
 	DummyDataSource() cache.SharedIndexInformer
 
@@ -548,6 +555,19 @@ func GetVirtualMachineInformerIndexers() cache.Indexers {
 			}
 			return pvcs, nil
 		},
+		"nad": func(obj interface{}) ([]string, error) {
+			vm, ok := obj.(*kubev1.VirtualMachine)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+			var nads []string
+			for _, network := range vm.Spec.Template.Spec.Networks {
+				if network.Multus != nil {
+					nads = append(nads, NetworkAttachmentDefinitionKey(vm.Namespace, network.Multus.NetworkName))
+				}
+			}
+			return nads, nil
+		},
 	}
 }
 
@@ -780,6 +800,21 @@ func (f *kubeInformerFactory) DummyDataSource() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) NetworkAttachmentDefinition() cache.SharedIndexInformer {
+	return f.getInformer("networkAttachmentDefinitionInformer", func() cache.SharedIndexInformer {
+		restClient := f.clientSet.NetworkClient().K8sCniCncfIoV1().RESTClient()
+		lw := cache.NewListWatchFromClient(restClient, "network-attachment-definitions", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &networkv1.NetworkAttachmentDefinition{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) DummyNetworkAttachmentDefinition() cache.SharedIndexInformer {
+	return f.getInformer("fakeNetworkAttachmentDefinitionInformer", func() cache.SharedIndexInformer {
+		informer, _ := testutils.NewFakeInformerFor(&networkv1.NetworkAttachmentDefinition{})
+		return informer
+	})
+}
+
 func (f *kubeInformerFactory) CDI() cache.SharedIndexInformer {
 	return f.getInformer("cdiInformer", func() cache.SharedIndexInformer {
 		restClient := f.clientSet.CdiClient().CdiV1beta1().RESTClient()
@@ -1069,6 +1069,55 @@ var _ = Describe("Prometheus", func() {
 			Expect(result.Desc().String()).To(ContainSubstring("kubevirt_vmi_network_transmit_packets_dropped_total"))
 		})
 
+		It("should report the network metrics of every interface by its alias", func() {
+			ch := make(chan prometheus.Metric, 2)
+			defer close(ch)
+
+			ps := prometheusScraper{ch: ch}
+
+			domainStats := &stats.DomainStats{
+				Cpu:    &stats.DomainStatsCPU{},
+				Memory: &stats.DomainStatsMemory{},
+				Net: []stats.DomainStatsNet{
+					{
+						NameSet:   true,
+						Name:      "tap0",
+						AliasSet:  true,
+						Alias:     "default",
+						TxPktsSet: true,
+						TxPkts:    1000,
+					},
+					{
+						NameSet:   true,
+						Name:      "tap61d1e4e8f4b",
+						AliasSet:  true,
+						Alias:     "hotplugged",
+						TxPktsSet: true,
+						TxPkts:    10,
+					},
+				},
+			}
+
+			vmi := k6tv1.VirtualMachineInstance{}
+			ps.Report("test", &vmi, newVmStats(domainStats, nil))
+
+			var reportedIfaces []string
+			for i := 0; i < len(domainStats.Net); i++ {
+				result := <-ch
+				Expect(result).ToNot(BeNil())
+				Expect(result.Desc().String()).To(ContainSubstring("kubevirt_vmi_network_transmit_packets_total"))
+
+				dto := &io_prometheus_client.Metric{}
+				Expect(result.Write(dto)).To(Succeed())
+				for _, label := range dto.GetLabel() {
+					if label.GetName() == "interface" {
+						reportedIfaces = append(reportedIfaces, label.GetValue())
+					}
+				}
+			}
+			Expect(reportedIfaces).To(ConsistOf("default", "hotplugged"))
+		})
+
 		It("should not expose nameless network interface metrics", func() {
 			ch := make(chan prometheus.Metric, 1)
 			defer close(ch)
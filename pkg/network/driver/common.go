@@ -50,6 +50,7 @@ const (
 	allowForwarding             = "1"
 	LibvirtUserAndGroupId       = "0"
 	allowRouteLocalNet          = "1"
+	enableProxyARP              = "1"
 )
 
 type IPVersion int
@@ -82,6 +83,7 @@ type NetworkHandler interface {
 	IsIpv4Primary() (bool, error)
 	ConfigureIpForwarding(ipVersion IPVersion) error
 	ConfigureRouteLocalNet(string) error
+	ConfigureProxyARP(string) error
 	ConfigureIpv4ArpIgnore() error
 	ConfigurePingGroupRange() error
 	ConfigureUnprivilegedPortStart(string) error
@@ -172,6 +174,15 @@ func (h *NetworkUtilsHandler) ConfigureRouteLocalNet(iface string) error {
 	return err
 }
 
+// ConfigureProxyARP makes the given interface answer ARP requests on behalf of the addresses
+// routed through other interfaces. The kernel proxies ARP only for the traffic it may forward,
+// forwarding is left as is.
+func (h *NetworkUtilsHandler) ConfigureProxyARP(iface string) error {
+	proxyARPForIface := fmt.Sprintf(sysctl.IPv4ProxyARP, iface)
+	err := sysctl.New().SetSysctl(proxyARPForIface, enableProxyARP)
+	return err
+}
+
 func (h *NetworkUtilsHandler) ConfigureUnprivilegedPortStart(port string) error {
 	err := sysctl.New().SetSysctl(sysctl.UnprivilegedPortStart, port)
 	return err
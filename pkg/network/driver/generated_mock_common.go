@@ -263,6 +263,16 @@ func (_mr *_MockNetworkHandlerRecorder) ConfigureRouteLocalNet(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ConfigureRouteLocalNet", arg0)
 }
 
+func (_m *MockNetworkHandler) ConfigureProxyARP(_param0 string) error {
+	ret := _m.ctrl.Call(_m, "ConfigureProxyARP", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockNetworkHandlerRecorder) ConfigureProxyARP(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ConfigureProxyARP", arg0)
+}
+
 func (_m *MockNetworkHandler) ConfigureIpv4ArpIgnore() error {
 	ret := _m.ctrl.Call(_m, "ConfigureIpv4ArpIgnore")
 	ret0, _ := ret[0].(error)
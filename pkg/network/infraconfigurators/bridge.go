@@ -66,6 +66,11 @@ func (b *BridgePodNetworkConfigurator) DiscoverPodNetworkInterface(podIfaceName
 
 	b.bridgeInterfaceName = virtnetlink.GenerateBridgeName(podIfaceName)
 	b.tapDeviceName = virtnetlink.GenerateTapDeviceName(podIfaceName)
+	for _, name := range []string{b.bridgeInterfaceName, b.tapDeviceName} {
+		if err := virtnetlink.ValidateInterfaceName(name); err != nil {
+			return err
+		}
+	}
 
 	b.vmMac, err = virtnetlink.RetrieveMacAddressFromVMISpecIface(b.vmiSpecIface)
 	if err != nil {
@@ -131,6 +136,13 @@ func (b *BridgePodNetworkConfigurator) PreparePodNetworkInterface() error {
 		return err
 	}
 
+	if b.vmiSpecIface.ProxyARP {
+		if err := b.handler.ConfigureProxyARP(b.bridgeInterfaceName); err != nil {
+			log.Log.Reason(err).Errorf("failed to enable proxy ARP on bridge %s", b.bridgeInterfaceName)
+			return err
+		}
+	}
+
 	tapOwner := netdriver.LibvirtUserAndGroupId
 	if util.IsNonRootVMI(b.vmi) {
 		tapOwner = strconv.Itoa(util.NonRootUID)
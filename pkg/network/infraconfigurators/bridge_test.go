@@ -161,6 +161,20 @@ var _ = Describe("Bridge infrastructure configurator", func() {
 			Expect(bridgeConfigurator.DiscoverPodNetworkInterface(ifaceName)).To(MatchError(errorString))
 		})
 
+		It("fails to discover pod information when the generated bridge name exceeds the max interface name length", func() {
+			const longIfaceName = "network-name-20chars"
+			longPodLink := &netlink.GenericLink{LinkAttrs: netlink.LinkAttrs{Name: longIfaceName, MTU: 1000}}
+			bridgeConfigurator := newMockedBridgeConfigurator(
+				vmi,
+				iface,
+				handler,
+				launcherPID,
+				withLink(longPodLink),
+				withIPOnLink(longPodLink))
+			Expect(bridgeConfigurator.DiscoverPodNetworkInterface(longIfaceName)).To(
+				MatchError(ContainSubstring("exceeds the max length")))
+		})
+
 		When("the pod does not report an IP address", func() {
 			var bridgeConfigurator *BridgePodNetworkConfigurator
 
@@ -494,6 +508,44 @@ var _ = Describe("Bridge infrastructure configurator", func() {
 				Expect(bridgeConfigurator.PreparePodNetworkInterface()).To(Succeed())
 			})
 
+			It("network preparation enables proxy ARP on the in-pod bridge when requested", func() {
+				iface.ProxyARP = true
+				bridgeConfigurator := newMockedBridgeConfiguratorForPreparePhase(
+					vmi,
+					iface,
+					handler,
+					bridgeIfaceName,
+					launcherPID,
+					withOriginalPodLinkDown(podLink),
+					withCreatedInPodBridge(inPodBridge, bridgeIPAddr),
+					withSwitchedPodLinkMac(podLink, inPodBridge),
+					withLinkAsBridgePort(inPodBridge, podLink),
+					withProxyARP(bridgeIfaceName),
+					withCreatedTapDevice(tapDeviceName, bridgeIfaceName, launcherPID, mtu, queueCount),
+					withDisabledTxOffloadChecksum(bridgeIfaceName),
+					withLinkLearningOff(podLink),
+					withLinkUp(podLink))
+				Expect(bridgeConfigurator.PreparePodNetworkInterface()).To(Succeed())
+			})
+
+			It("network preparation fails when enabling proxy ARP on the in-pod bridge errors", func() {
+				const errorString = "failed to enable proxy ARP"
+				iface.ProxyARP = true
+				bridgeConfigurator := newMockedBridgeConfiguratorForPreparePhase(
+					vmi,
+					iface,
+					handler,
+					bridgeIfaceName,
+					launcherPID,
+					withOriginalPodLinkDown(podLink),
+					withCreatedInPodBridge(inPodBridge, bridgeIPAddr),
+					withSwitchedPodLinkMac(podLink, inPodBridge),
+					withLinkAsBridgePort(inPodBridge, podLink),
+					withDisabledTxOffloadChecksum(bridgeIfaceName),
+					withErrorProxyARP(bridgeIfaceName, errorString))
+				Expect(bridgeConfigurator.PreparePodNetworkInterface()).To(MatchError(errorString))
+			})
+
 			It("network preparation fails when setting the pod link down errors", func() {
 				const errorString = "failed to set link down"
 				bridgeConfigurator := newMockedBridgeConfiguratorForPreparePhase(
@@ -894,6 +946,18 @@ func withErrorSwitchingPodLinkMac(link netlink.Link, bridge *netlink.Bridge, err
 	}
 }
 
+func withProxyARP(bridgeName string) Option {
+	return func(handler *netdriver.MockNetworkHandler) {
+		handler.EXPECT().ConfigureProxyARP(bridgeName)
+	}
+}
+
+func withErrorProxyARP(bridgeName string, errorString string) Option {
+	return func(handler *netdriver.MockNetworkHandler) {
+		handler.EXPECT().ConfigureProxyARP(bridgeName).Return(fmt.Errorf(errorString))
+	}
+}
+
 func withARPIgnore() Option {
 	return func(handler *netdriver.MockNetworkHandler) {
 		handler.EXPECT().ConfigureIpv4ArpIgnore()
@@ -22,10 +22,14 @@ package link
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 )
 
+// MaxInterfaceNameLength is the max kernel interface name length (IFNAMSIZ), excluding the terminating null byte.
+const MaxInterfaceNameLength = 15
+
 func GenerateTapDeviceName(podInterfaceName string) string {
 	return "tap" + podInterfaceName[3:]
 }
@@ -39,3 +43,17 @@ func GenerateNewBridgedVmiInterfaceName(originalPodInterfaceName string) string
 	trimmedName := strings.TrimPrefix(originalPodInterfaceName, namescheme.HashedIfacePrefix)
 	return fmt.Sprintf("%s-nic", trimmedName)
 }
+
+// ValidateInterfaceName checks the given name is accepted by the kernel as an interface name.
+func ValidateInterfaceName(name string) error {
+	if name == "" || name == "." || name == ".." {
+		return fmt.Errorf("invalid interface name %q", name)
+	}
+	if len(name) > MaxInterfaceNameLength {
+		return fmt.Errorf("interface name %q exceeds the max length of %d characters", name, MaxInterfaceNameLength)
+	}
+	if strings.ContainsAny(name, "/:") || strings.IndexFunc(name, unicode.IsSpace) != -1 {
+		return fmt.Errorf("interface name %q contains an invalid character", name)
+	}
+	return nil
+}
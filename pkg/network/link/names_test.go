@@ -24,10 +24,11 @@ import (
 	. "github.com/onsi/gomega"
 
 	virtnetlink "kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
 )
 
 var _ = Describe("Common Methods", func() {
-	const maxInterfaceNameLength = 15
+	const maxInterfaceNameLength = virtnetlink.MaxInterfaceNameLength
 
 	Context("GenerateTapDeviceName function", func() {
 		It("Should return a tap device name with one digit suffix", func() {
@@ -71,4 +72,42 @@ var _ = Describe("Common Methods", func() {
 			Expect(hashedIfaceName).To(Equal("k6t-16477688c0e"))
 		})
 	})
+	Context("ValidateInterfaceName function", func() {
+		DescribeTable("Should accept a valid interface name", func(name string) {
+			Expect(virtnetlink.ValidateInterfaceName(name)).To(Succeed())
+		},
+			Entry("primary pod interface", "eth0"),
+			Entry("hashed bridge name at the max length", "k6t-16477688c0e"),
+			Entry("hashed in-pod nic name at the max length", "16477688c0e-nic"),
+		)
+		DescribeTable("Should reject an invalid interface name", func(name string) {
+			Expect(virtnetlink.ValidateInterfaceName(name)).NotTo(Succeed())
+		},
+			Entry("empty name", ""),
+			Entry("current directory name", "."),
+			Entry("parent directory name", ".."),
+			Entry("name exceeding the max length", "k6t-network-name-20chars"),
+			Entry("name with a slash", "k6t/eth0"),
+			Entry("name with a colon", "k6t:eth0"),
+			Entry("name with a space", "k6t eth0"),
+		)
+	})
+	Context("given a network name exceeding the max interface name length", func() {
+		const networkName = "network-name-20chars"
+
+		It("Should generate valid and stable host device names", func() {
+			podIfaceName := namescheme.GenerateHashedInterfaceName(networkName)
+			Expect(podIfaceName).To(Equal("pod719c1c3b443"))
+			Expect(namescheme.GenerateHashedInterfaceName(networkName)).To(Equal(podIfaceName))
+
+			for _, name := range []string{
+				podIfaceName,
+				virtnetlink.GenerateBridgeName(podIfaceName),
+				virtnetlink.GenerateTapDeviceName(podIfaceName),
+				virtnetlink.GenerateNewBridgedVmiInterfaceName(podIfaceName),
+			} {
+				Expect(virtnetlink.ValidateInterfaceName(name)).To(Succeed())
+			}
+		})
+	})
 })
@@ -69,3 +69,57 @@ func NetworksToHotplugWhosePodIfacesAreReady(vmi *v1.VirtualMachineInstance) []v
 
 	return networksToHotplug
 }
+
+// DesiredInterface is a secondary interface, along with the network it is connected to,
+// declared by an external controller which manages the interfaces of a VM.
+type DesiredInterface struct {
+	Interface v1.Interface `json:"interface"`
+	Network   v1.Network   `json:"network"`
+}
+
+// ReconcileDesiredInterfaces returns the interfaces and networks of the given spec, updated to match the
+// secondary interfaces declared as desired by an external controller:
+//   - Desired interfaces missing from the spec are added, requesting them to be hotplugged.
+//   - Desired interfaces marked as absent in the spec are no longer marked, requesting them to be plugged again.
+//   - Secondary interfaces of the spec which are not desired are marked as absent, requesting them to be unplugged.
+//
+// Interfaces which are not connected to a secondary network are kept as is.
+// The result is expected to be applied on the VM template spec.
+func ReconcileDesiredInterfaces(spec *v1.VirtualMachineInstanceSpec, desiredIfaces []DesiredInterface) ([]v1.Interface, []v1.Network) {
+	desiredIfaceNames := map[string]struct{}{}
+	for _, desiredIface := range desiredIfaces {
+		desiredIfaceNames[desiredIface.Interface.Name] = struct{}{}
+	}
+
+	indexedNetworks := IndexNetworkSpecByName(spec.Networks)
+	var ifaces []v1.Interface
+	for _, iface := range spec.Domain.Devices.Interfaces {
+		iface := *iface.DeepCopy()
+		if IsSecondaryMultusNetwork(indexedNetworks[iface.Name]) {
+			if _, isDesired := desiredIfaceNames[iface.Name]; isDesired {
+				if iface.State == v1.InterfaceStateAbsent {
+					iface.State = ""
+				}
+			} else {
+				iface.State = v1.InterfaceStateAbsent
+			}
+		}
+		ifaces = append(ifaces, iface)
+	}
+
+	var networks []v1.Network
+	for _, network := range spec.Networks {
+		networks = append(networks, *network.DeepCopy())
+	}
+
+	indexedIfaces := IndexInterfaceSpecByName(spec.Domain.Devices.Interfaces)
+	for _, desiredIface := range desiredIfaces {
+		if _, exists := indexedIfaces[desiredIface.Interface.Name]; exists {
+			continue
+		}
+		ifaces = append(ifaces, *desiredIface.Interface.DeepCopy())
+		networks = append(networks, *desiredIface.Network.DeepCopy())
+	}
+
+	return ifaces, networks
+}
@@ -183,3 +183,79 @@ func newVMI() *v1.VirtualMachineInstance {
 	vmi.Spec = v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{}}
 	return vmi
 }
+
+var _ = Describe("reconcile the interfaces declared by an external controller", func() {
+	newDesiredInterface := func(networkName string) vmispec.DesiredInterface {
+		return vmispec.DesiredInterface{
+			Interface: v1.Interface{Name: networkName, InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			Network:   v1.Network{Name: networkName, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: networkName}}},
+		}
+	}
+
+	newDesiredInterfaces := func(networkNames ...string) []vmispec.DesiredInterface {
+		var desiredIfaces []vmispec.DesiredInterface
+		for _, networkName := range networkNames {
+			desiredIfaces = append(desiredIfaces, newDesiredInterface(networkName))
+		}
+		return desiredIfaces
+	}
+
+	newSpec := func(secondaryNetworkNames ...string) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{
+			Networks: []v1.Network{*v1.DefaultPodNetwork()},
+		}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		for _, desiredIface := range newDesiredInterfaces(secondaryNetworkNames...) {
+			spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, desiredIface.Interface)
+			spec.Networks = append(spec.Networks, desiredIface.Network)
+		}
+		return spec
+	}
+
+	It("plugs the desired interfaces and unplugs the undesired ones", func() {
+		spec := newSpec("red")
+
+		ifaces, networks := vmispec.ReconcileDesiredInterfaces(spec, newDesiredInterfaces("blue", "green"))
+
+		expectedSpec := newSpec("red", "blue", "green")
+		vmispec.LookupInterfaceByName(expectedSpec.Domain.Devices.Interfaces, "red").State = v1.InterfaceStateAbsent
+		Expect(ifaces).To(Equal(expectedSpec.Domain.Devices.Interfaces))
+		Expect(networks).To(Equal(expectedSpec.Networks))
+
+		By("keeping the original spec untouched")
+		Expect(spec).To(Equal(newSpec("red")))
+	})
+
+	It("does not change a spec which already matches the desired interfaces", func() {
+		spec := newSpec("blue", "green", "red")
+		vmispec.LookupInterfaceByName(spec.Domain.Devices.Interfaces, "red").State = v1.InterfaceStateAbsent
+
+		ifaces, networks := vmispec.ReconcileDesiredInterfaces(spec, newDesiredInterfaces("blue", "green"))
+
+		Expect(ifaces).To(Equal(spec.Domain.Devices.Interfaces))
+		Expect(networks).To(Equal(spec.Networks))
+	})
+
+	It("plugs again a desired interface which was unplugged", func() {
+		spec := newSpec("red")
+		vmispec.LookupInterfaceByName(spec.Domain.Devices.Interfaces, "red").State = v1.InterfaceStateAbsent
+
+		ifaces, networks := vmispec.ReconcileDesiredInterfaces(spec, newDesiredInterfaces("red"))
+
+		expectedSpec := newSpec("red")
+		Expect(ifaces).To(Equal(expectedSpec.Domain.Devices.Interfaces))
+		Expect(networks).To(Equal(expectedSpec.Networks))
+	})
+
+	It("unplugs all the secondary interfaces, keeping the pod network interface, when no interface is desired", func() {
+		spec := newSpec("red", "blue")
+
+		ifaces, networks := vmispec.ReconcileDesiredInterfaces(spec, nil)
+
+		expectedSpec := newSpec("red", "blue")
+		vmispec.LookupInterfaceByName(expectedSpec.Domain.Devices.Interfaces, "red").State = v1.InterfaceStateAbsent
+		vmispec.LookupInterfaceByName(expectedSpec.Domain.Devices.Interfaces, "blue").State = v1.InterfaceStateAbsent
+		Expect(ifaces).To(Equal(expectedSpec.Domain.Devices.Interfaces))
+		Expect(networks).To(Equal(expectedSpec.Networks))
+	})
+})
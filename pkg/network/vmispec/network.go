@@ -54,6 +54,15 @@ func LookUpDefaultNetwork(networks []v1.Network) *v1.Network {
 	return nil
 }
 
+func LookupNetworkByName(networks []v1.Network, name string) *v1.Network {
+	for idx := range networks {
+		if networks[idx].Name == name {
+			return &networks[idx]
+		}
+	}
+	return nil
+}
+
 func IsSecondaryMultusNetwork(net v1.Network) bool {
 	return net.Multus != nil && !net.Multus.Default
 }
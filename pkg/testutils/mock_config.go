@@ -104,6 +104,19 @@ func UpdateFakeKubeVirtClusterConfig(kubeVirtInformer cache.SharedIndexInformer,
 	kubeVirtInformer.GetStore().Update(clone)
 }
 
+func AddNetworkAttachmentDefinitionAPI(crdInformer cache.SharedIndexInformer) {
+	crdInformer.GetStore().Add(&extv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "network-attachment-definitions.k8s.cni.cncf.io",
+		},
+		Spec: extv1.CustomResourceDefinitionSpec{
+			Names: extv1.CustomResourceDefinitionNames{
+				Kind: "NetworkAttachmentDefinition",
+			},
+		},
+	})
+}
+
 func AddServiceMonitorAPI(crdInformer cache.SharedIndexInformer) {
 	crdInformer.GetStore().Add(&extv1.CustomResourceDefinition{
 		ObjectMeta: metav1.ObjectMeta{
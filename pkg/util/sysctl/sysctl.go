@@ -31,6 +31,7 @@ const (
 	Ipv4ArpIgnoreAll      = "net/ipv4/conf/all/arp_ignore"
 	PingGroupRange        = "net/ipv4/ping_group_range"
 	IPv4RouteLocalNet     = "net/ipv4/conf/%s/route_localnet"
+	IPv4ProxyARP          = "net/ipv4/conf/%s/proxy_arp"
 	UnprivilegedPortStart = "net/ipv4/ip_unprivileged_port_start"
 )
 
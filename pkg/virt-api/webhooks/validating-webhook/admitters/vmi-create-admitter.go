@@ -287,6 +287,8 @@ func validateNetworksMatchInterfaces(field *k8sfield.Path, spec *v1.VirtualMachi
 		causes = append(causes, validateMacAddress(field, iface, idx)...)
 		causes = append(causes, validateInterfaceBootOrder(field, iface, idx, bootOrderMap)...)
 		causes = append(causes, validateInterfacePciAddress(field, iface, idx)...)
+		causes = append(causes, validateInterfaceRingBuffers(field, iface, idx)...)
+		causes = append(causes, validateInterfaceProxyARP(field, iface, idx)...)
 
 		newCauses, newDone := validateDHCPExtraOptions(field, iface)
 		causes = append(causes, newCauses...)
@@ -392,6 +394,61 @@ func validateInterfacePciAddress(field *k8sfield.Path, iface v1.Interface, idx i
 	return causes
 }
 
+// The ring buffer sizes accepted by the virtio-net device: the rx size is a power of 2 within the
+// minimum and maximum sizes, while the tap backend supports only the minimum tx size.
+const (
+	minRingBufferSize = 256
+	maxRingBufferSize = 1024
+)
+
+func validateInterfaceRingBuffers(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	if iface.RingBuffers == nil {
+		return nil
+	}
+	ringBuffersField := field.Child("domain", "devices", "interfaces").Index(idx).Child("ringBuffers")
+	if iface.SRIOV != nil || iface.Slirp != nil || (iface.Model != "" && iface.Model != v1.VirtIO) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is supported only by interfaces backed by a virtio-net device.", ringBuffersField.String()),
+			Field:   ringBuffersField.String(),
+		})
+	}
+	if iface.RingBuffers.RX == nil && iface.RingBuffers.TX == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must specify the rx and/or tx ring buffer size.", ringBuffersField.String()),
+			Field:   ringBuffersField.String(),
+		})
+	}
+	if rx := iface.RingBuffers.RX; rx != nil && (*rx < minRingBufferSize || *rx > maxRingBufferSize || *rx&(*rx-1) != 0) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be a power of 2 between %d and %d, if supplied.", ringBuffersField.Child("rx").String(), minRingBufferSize, maxRingBufferSize),
+			Field:   ringBuffersField.Child("rx").String(),
+		})
+	}
+	if tx := iface.RingBuffers.TX; tx != nil && *tx != minRingBufferSize {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be %d, the only size supported by the tap backend, if supplied.", ringBuffersField.Child("tx").String(), minRingBufferSize),
+			Field:   ringBuffersField.Child("tx").String(),
+		})
+	}
+	return causes
+}
+
+func validateInterfaceProxyARP(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	if iface.ProxyARP && iface.Bridge == nil {
+		proxyARPField := field.Child("domain", "devices", "interfaces").Index(idx).Child("proxyARP")
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is supported only by interfaces with bridge binding.", proxyARPField.String()),
+			Field:   proxyARPField.String(),
+		})
+	}
+	return causes
+}
+
 func validateInterfaceBootOrder(field *k8sfield.Path, iface v1.Interface, idx int, bootOrderMap map[uint]bool) (causes []metav1.StatusCause) {
 	if iface.BootOrder != nil {
 		order := *iface.BootOrder
@@ -1955,6 +1955,66 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			}
 		})
 
+		It("should accept valid interface ring buffer sizes", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].RingBuffers = &v1.InterfaceRingBuffers{
+				RX: pointer.Uint32(1024),
+				TX: pointer.Uint32(256),
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject invalid interface ring buffer sizes", func(ringBuffers v1.InterfaceRingBuffers, expectedField string) {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].RingBuffers = &ringBuffers
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("when no size is specified", v1.InterfaceRingBuffers{}, "fake.domain.devices.interfaces[0].ringBuffers"),
+			Entry("when the rx size is zero", v1.InterfaceRingBuffers{RX: pointer.Uint32(0)}, "fake.domain.devices.interfaces[0].ringBuffers.rx"),
+			Entry("when the rx size is below the minimum", v1.InterfaceRingBuffers{RX: pointer.Uint32(128)}, "fake.domain.devices.interfaces[0].ringBuffers.rx"),
+			Entry("when the rx size is above the maximum", v1.InterfaceRingBuffers{RX: pointer.Uint32(2048)}, "fake.domain.devices.interfaces[0].ringBuffers.rx"),
+			Entry("when the rx size is not a power of 2", v1.InterfaceRingBuffers{RX: pointer.Uint32(384)}, "fake.domain.devices.interfaces[0].ringBuffers.rx"),
+			Entry("when the tx size is zero", v1.InterfaceRingBuffers{TX: pointer.Uint32(0)}, "fake.domain.devices.interfaces[0].ringBuffers.tx"),
+			Entry("when the tx size is not supported by the tap backend", v1.InterfaceRingBuffers{TX: pointer.Uint32(512)}, "fake.domain.devices.interfaces[0].ringBuffers.tx"),
+		)
+
+		It("should reject interface ring buffer sizes on a device other than virtio-net", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+			vmi.Spec.Domain.Devices.Interfaces[0].RingBuffers = &v1.InterfaceRingBuffers{RX: pointer.Uint32(1024)}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].ringBuffers"))
+		})
+
+		It("should accept proxy ARP on an interface with bridge binding", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].ProxyARP = true
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject proxy ARP on an interface without bridge binding", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].ProxyARP = true
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].proxyARP"))
+		})
+
 		It("should accept valid NTP servers", func() {
 			vmi := api.NewMinimalVMI("testvm")
 			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
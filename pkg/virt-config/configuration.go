@@ -29,6 +29,7 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/pointer"
 
@@ -125,6 +126,10 @@ func isDataSourceCrd(crd *extv1.CustomResourceDefinition) bool {
 	return crd.Spec.Names.Kind == "DataSource"
 }
 
+func isNetworkAttachmentDefinitionCrd(crd *extv1.CustomResourceDefinition) bool {
+	return crd.Spec.Names.Kind == "NetworkAttachmentDefinition"
+}
+
 func isServiceMonitor(crd *extv1.CustomResourceDefinition) bool {
 	return crd.Spec.Names.Kind == "ServiceMonitor"
 }
@@ -136,7 +141,7 @@ func isPrometheusRules(crd *extv1.CustomResourceDefinition) bool {
 func (c *ClusterConfig) crdAddedDeleted(obj interface{}) {
 	go c.GetConfig()
 	crd := obj.(*extv1.CustomResourceDefinition)
-	if !isDataVolumeCrd(crd) && !isDataSourceCrd(crd) &&
+	if !isDataVolumeCrd(crd) && !isDataSourceCrd(crd) && !isNetworkAttachmentDefinitionCrd(crd) &&
 		!isServiceMonitor(crd) && !isPrometheusRules(crd) {
 		return
 	}
@@ -216,6 +221,9 @@ func defaultClusterConfig(cpuArch string) *v1.KubeVirtConfiguration {
 			NetworkInterface:                  defaultNetworkInterface,
 			PermitSlirpInterface:              pointer.BoolPtr(DefaultPermitSlirpInterface),
 			PermitBridgeInterfaceOnPodNetwork: pointer.BoolPtr(DefaultPermitBridgeInterfaceOnPodNetwork),
+			UnplugInterfacesOfDeletedNetworks: pointer.BoolPtr(DefaultUnplugInterfacesOfDeletedNetworks),
+			RetainMACOnUnplug:                 pointer.BoolPtr(DefaultRetainMACOnUnplug),
+			InPlaceHotplugTimeout:             &metav1.Duration{Duration: DefaultInPlaceHotplugTimeout},
 		},
 		SMBIOSConfig:                SmbiosDefaultConfig,
 		SELinuxLauncherType:         DefaultSELinuxLauncherType,
@@ -418,6 +426,21 @@ func (c *ClusterConfig) HasDataVolumeAPI() bool {
 	return false
 }
 
+func (c *ClusterConfig) HasNetworkAttachmentDefinitionAPI() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	objects := c.crdInformer.GetStore().List()
+	for _, obj := range objects {
+		if crd, ok := obj.(*extv1.CustomResourceDefinition); ok && crd.DeletionTimestamp == nil {
+			if isNetworkAttachmentDefinitionCrd(crd) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (c *ClusterConfig) HasServiceMonitorAPI() bool {
 	c.lock.Lock()
 	defer c.lock.Unlock()
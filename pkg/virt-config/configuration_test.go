@@ -643,7 +643,7 @@ var _ = Describe("test configuration", func() {
 			func(c *v1.KubeVirtConfiguration) interface{} {
 				return c.NetworkConfiguration
 			},
-			`{"defaultNetworkInterface":"bridge","permitSlirpInterface":false,"permitBridgeInterfaceOnPodNetwork":true}`),
+			`{"defaultNetworkInterface":"bridge","permitSlirpInterface":false,"permitBridgeInterfaceOnPodNetwork":true,"unplugInterfacesOfDeletedNetworks":false,"retainMACOnUnplug":false,"inPlaceHotplugTimeout":"30s"}`),
 		Entry("when networkConfiguration set, should equal to result",
 			v1.KubeVirtConfiguration{
 				NetworkConfiguration: &v1.NetworkConfiguration{
@@ -655,7 +655,7 @@ var _ = Describe("test configuration", func() {
 			func(c *v1.KubeVirtConfiguration) interface{} {
 				return c.NetworkConfiguration
 			},
-			`{"defaultNetworkInterface":"slirp","permitSlirpInterface":true,"permitBridgeInterfaceOnPodNetwork":false}`),
+			`{"defaultNetworkInterface":"slirp","permitSlirpInterface":true,"permitBridgeInterfaceOnPodNetwork":false,"unplugInterfacesOfDeletedNetworks":false,"retainMACOnUnplug":false,"inPlaceHotplugTimeout":"30s"}`),
 		Entry("when networkConfiguration set with empty NetworkInterface, should use the default",
 			v1.KubeVirtConfiguration{
 				NetworkConfiguration: &v1.NetworkConfiguration{
@@ -666,7 +666,7 @@ var _ = Describe("test configuration", func() {
 			func(c *v1.KubeVirtConfiguration) interface{} {
 				return c.NetworkConfiguration
 			},
-			`{"defaultNetworkInterface":"bridge","permitSlirpInterface":true,"permitBridgeInterfaceOnPodNetwork":false}`),
+			`{"defaultNetworkInterface":"bridge","permitSlirpInterface":true,"permitBridgeInterfaceOnPodNetwork":false,"unplugInterfacesOfDeletedNetworks":false,"retainMACOnUnplug":false,"inPlaceHotplugTimeout":"30s"}`),
 	)
 
 	DescribeTable("when ClusterProfiler feature-gate", func(openFeatureGates []string, isEnabled bool) {
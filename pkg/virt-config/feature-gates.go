@@ -63,6 +63,10 @@ const (
 	DisableMediatedDevicesHandling = "DisableMDEVConfiguration"
 	// HotplugNetworkIfacesGate enables the virtio network interface hotplug feature
 	HotplugNetworkIfacesGate = "HotplugNICs"
+	// HotplugNetworkIfacesEagerMigrationGate makes virt-controller migrate a VMI to plug its hotplugged network
+	// interfaces, creating the target pod without waiting for the migration to be requested, to speed up migration
+	// based hotplug. The migration is delayed by the network configuration in-place hotplug timeout, 30s by default
+	HotplugNetworkIfacesEagerMigrationGate = "HotplugNICsEagerMigration"
 	// PersistentReservation enables the use of the SCSI persistent reservation with the pr-helper daemon
 	PersistentReservation = "PersistentReservation"
 	// VMPersistentState enables persisting backend state files of VMs, such as the contents of the vTPM
@@ -218,6 +222,10 @@ func (config *ClusterConfig) HotplugNetworkInterfacesEnabled() bool {
 	return config.isFeatureGateEnabled(HotplugNetworkIfacesGate)
 }
 
+func (config *ClusterConfig) HotplugNetworkInterfacesEagerMigrationEnabled() bool {
+	return config.isFeatureGateEnabled(HotplugNetworkIfacesEagerMigrationGate)
+}
+
 func (config *ClusterConfig) PersistentReservationEnabled() bool {
 	return config.isFeatureGateEnabled(PersistentReservation)
 }
@@ -25,6 +25,7 @@ package virtconfig
 
 import (
 	"fmt"
+	"time"
 
 	"kubevirt.io/client-go/log"
 
@@ -62,6 +63,9 @@ const (
 	SmbiosConfigDefaultManufacturer                 = "KubeVirt"
 	SmbiosConfigDefaultProduct                      = "None"
 	DefaultPermitBridgeInterfaceOnPodNetwork        = true
+	DefaultUnplugInterfacesOfDeletedNetworks        = false
+	DefaultRetainMACOnUnplug                        = false
+	DefaultInPlaceHotplugTimeout                    = 30 * time.Second
 	DefaultSELinuxLauncherType                      = ""
 	SupportedGuestAgentVersions                     = "2.*,3.*,4.*,5.*"
 	DefaultARCHOVMFPath                             = "/usr/share/OVMF"
@@ -229,6 +233,18 @@ func (c *ClusterConfig) IsBridgeInterfaceOnPodNetworkEnabled() bool {
 	return *c.GetConfig().NetworkConfiguration.PermitBridgeInterfaceOnPodNetwork
 }
 
+func (c *ClusterConfig) IsUnplugInterfacesOfDeletedNetworksEnabled() bool {
+	return *c.GetConfig().NetworkConfiguration.UnplugInterfacesOfDeletedNetworks
+}
+
+func (c *ClusterConfig) IsRetainMACOnUnplugEnabled() bool {
+	return *c.GetConfig().NetworkConfiguration.RetainMACOnUnplug
+}
+
+func (c *ClusterConfig) GetInPlaceHotplugTimeout() time.Duration {
+	return c.GetConfig().NetworkConfiguration.InPlaceHotplugTimeout.Duration
+}
+
 func (c *ClusterConfig) GetDefaultClusterConfig() *v1.KubeVirtConfiguration {
 	return c.defaultConfig
 }
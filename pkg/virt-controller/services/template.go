@@ -131,7 +131,7 @@ const (
 const customSELinuxType = "virt_launcher.process"
 
 type TemplateService interface {
-	RenderMigrationManifest(vmi *v1.VirtualMachineInstance, sourcePod *k8sv1.Pod) (*k8sv1.Pod, error)
+	RenderMigrationManifest(vmi *v1.VirtualMachineInstance, sourcePod *k8sv1.Pod, plugsInterfaces bool) (*k8sv1.Pod, error)
 	RenderLaunchManifest(vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error)
 	RenderHotplugAttachmentPodTemplate(volume []*v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, claimMap map[string]*k8sv1.PersistentVolumeClaim, tempPod bool) (*k8sv1.Pod, error)
 	RenderHotplugAttachmentTriggerPodTemplate(volume *v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, pvcName string, isBlock bool, tempPod bool) (*k8sv1.Pod, error)
@@ -254,7 +254,9 @@ func (t *templateService) RenderLaunchManifestNoVm(vmi *v1.VirtualMachineInstanc
 	return t.renderLaunchManifest(vmi, nil, true)
 }
 
-func (t *templateService) RenderMigrationManifest(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) (*k8sv1.Pod, error) {
+// RenderMigrationManifest renders the migration target pod of the vmi. When the migration plugs
+// network interfaces into the vmi, the target pod keeps the scheduling constraints of the source pod.
+func (t *templateService) RenderMigrationManifest(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod, plugsInterfaces bool) (*k8sv1.Pod, error) {
 	reproducibleImageIDs, err := containerdisk.ExtractImageIDsFromSourcePod(vmi, pod)
 	if err != nil {
 		return nil, fmt.Errorf("can not proceed with the migration when no reproducible image digest can be detected: %v", err)
@@ -274,9 +276,27 @@ func (t *templateService) RenderMigrationManifest(vmi *v1.VirtualMachineInstance
 		podManifest.Annotations[networkv1.NetworkAttachmentAnnot] = multusNetworksAnnotation
 	}
 
+	if plugsInterfaces {
+		inheritSchedulingConstraints(podManifest, pod)
+	}
+
 	return podManifest, err
 }
 
+// inheritSchedulingConstraints makes the migration target pod run under the same runtime class and
+// node selectors as the source pod, even if the cluster configuration used to render it has changed since.
+// The constraints of the source pod take precedence over the ones rendered from the current configuration.
+func inheritSchedulingConstraints(targetPod, sourcePod *k8sv1.Pod) {
+	targetPod.Spec.RuntimeClassName = sourcePod.Spec.RuntimeClassName
+
+	if len(sourcePod.Spec.NodeSelector) > 0 && targetPod.Spec.NodeSelector == nil {
+		targetPod.Spec.NodeSelector = map[string]string{}
+	}
+	for key, value := range sourcePod.Spec.NodeSelector {
+		targetPod.Spec.NodeSelector[key] = value
+	}
+}
+
 func (t *templateService) RenderLaunchManifest(vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error) {
 	return t.renderLaunchManifest(vmi, nil, false)
 }
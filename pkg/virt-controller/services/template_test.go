@@ -1112,7 +1112,7 @@ var _ = Describe("Template", func() {
 					Expect(err).ToNot(HaveOccurred())
 					sourcePod.ObjectMeta.Annotations[networkv1.NetworkStatusAnnot] = migrationSourcePodNetworksAnnotation[networkv1.NetworkStatusAnnot]
 
-					targetPod, err := svc.RenderMigrationManifest(vmi, sourcePod)
+					targetPod, err := svc.RenderMigrationManifest(vmi, sourcePod, false)
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(targetPod.Annotations[MultusNetworksAnnotation]).To(MatchJSON(expectedTargetPodMultusNetworksAnnotation[MultusNetworksAnnotation]))
@@ -1338,6 +1338,42 @@ var _ = Describe("Template", func() {
 				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue("node-role.kubernetes.io/compute", "true"))
 			})
 
+			DescribeTable("should render the node selectors of a migration target pod", func(plugsInterfaces bool, expectedNodeSelector map[string]string) {
+				config, kvInformer, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DeveloperConfiguration.NodeSelectors = map[string]string{"node-role.kubernetes.io/compute": "true"}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kvConfig)
+
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Volumes: []v1.Volume{}, Domain: v1.DomainSpec{
+						Devices: v1.Devices{
+							DisableHotplug: true,
+						},
+					}},
+				}
+				sourcePod := &k8sv1.Pod{Spec: k8sv1.PodSpec{NodeSelector: map[string]string{
+					"node-role.kubernetes.io/compute": "false",
+					"example.io/rack":                 "rack1",
+				}}}
+
+				pod, err := svc.RenderMigrationManifest(&vmi, sourcePod, plugsInterfaces)
+				Expect(err).ToNot(HaveOccurred())
+				for key, value := range expectedNodeSelector {
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(key, value))
+				}
+				if !plugsInterfaces {
+					Expect(pod.Spec.NodeSelector).ToNot(HaveKey("example.io/rack"))
+				}
+			},
+				Entry("from the configuration, when the migration does not plug interfaces", false,
+					map[string]string{"node-role.kubernetes.io/compute": "true"}),
+				Entry("from the source pod, when the migration plugs interfaces", true,
+					map[string]string{"node-role.kubernetes.io/compute": "false", "example.io/rack": "rack1"}),
+			)
+
 			It("should not add node selector for hyperv nodes if VMI does not request hyperv features", func() {
 				config, kvInformer, svc = configFactory(defaultArch)
 				enableFeatureGate(virtconfig.HypervStrictCheckGate)
@@ -3643,6 +3679,70 @@ var _ = Describe("Template", func() {
 				Expect(*pod.Spec.RuntimeClassName).To(BeEquivalentTo(runtimeClassName))
 			})
 
+			It("Should inherit the runtimeClassName of the migration source pod, if the migration plugs interfaces", func() {
+				config, kvInformer, svc = configFactory(defaultArch)
+				const sourceRuntimeClassName = "sourceRuntime"
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DefaultRuntimeClass = "customRuntime"
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kvConfig)
+
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "namespace",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{},
+				}
+				sourcePod := &k8sv1.Pod{Spec: k8sv1.PodSpec{RuntimeClassName: pointer.String(sourceRuntimeClassName)}}
+
+				pod, err := svc.RenderMigrationManifest(&vmi, sourcePod, true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.RuntimeClassName).To(HaveValue(Equal(sourceRuntimeClassName)))
+			})
+
+			It("Should set the configured runtimeClassName on the migration target pod, if the migration does not plug interfaces", func() {
+				config, kvInformer, svc = configFactory(defaultArch)
+				const runtimeClassName = "customRuntime"
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DefaultRuntimeClass = runtimeClassName
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kvConfig)
+
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "namespace",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{},
+				}
+				sourcePod := &k8sv1.Pod{Spec: k8sv1.PodSpec{RuntimeClassName: pointer.String("sourceRuntime")}}
+
+				pod, err := svc.RenderMigrationManifest(&vmi, sourcePod, false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.RuntimeClassName).To(HaveValue(Equal(runtimeClassName)))
+			})
+
+			It("Should leave runtimeClassName unset on the migration target pod, if unset on the source pod and the migration plugs interfaces", func() {
+				config, kvInformer, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DefaultRuntimeClass = "customRuntime"
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kvConfig)
+
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "namespace",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{},
+				}
+
+				pod, err := svc.RenderMigrationManifest(&vmi, &k8sv1.Pod{}, true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.RuntimeClassName).To(BeNil())
+			})
+
 			It("Should leave runtimeClassName unset on pod, if not configured", func() {
 				config, kvInformer, svc = configFactory(defaultArch)
 				vmi := v1.VirtualMachineInstance{
@@ -176,6 +176,7 @@ type VirtControllerApp struct {
 	controllerRevisionInformer cache.SharedIndexInformer
 
 	dataVolumeInformer cache.SharedIndexInformer
+	nadInformer        cache.SharedIndexInformer
 	cdiInformer        cache.SharedIndexInformer
 	cdiConfigInformer  cache.SharedIndexInformer
 
@@ -234,6 +235,8 @@ type VirtControllerApp struct {
 
 	// indicates if controllers were started with or without CDI/DataVolume support
 	hasCDI bool
+	// indicates if controllers were started with or without NetworkAttachmentDefinition support
+	hasNAD bool
 	// the channel used to trigger re-initialization.
 	reInitChan chan string
 
@@ -339,6 +342,7 @@ func Execute() {
 
 	app.reInitChan = make(chan string, 10)
 	app.hasCDI = app.clusterConfig.HasDataVolumeAPI()
+	app.hasNAD = app.clusterConfig.HasNetworkAttachmentDefinitionAPI()
 	app.clusterConfig.SetConfigModifiedCallback(app.configModificationCallback)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeLogVerbosity)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeRateLimiter)
@@ -403,6 +407,12 @@ func Execute() {
 		log.Log.Infof("CDI not detected, DataVolume integration disabled")
 	}
 
+	if app.hasNAD {
+		app.nadInformer = app.informerFactory.NetworkAttachmentDefinition()
+	} else {
+		app.nadInformer = app.informerFactory.DummyNetworkAttachmentDefinition()
+	}
+
 	onOpenShift, err := clusterutil.IsOnOpenShift(app.clientSet)
 	if err != nil {
 		golog.Fatalf("Error determining cluster type: %v", err)
@@ -454,6 +464,17 @@ func (vca *VirtControllerApp) configModificationCallback() {
 			log.Log.Infof("Reinitialize virt-controller, cdi api has been removed")
 		}
 		vca.reInitChan <- "reinit"
+		return
+	}
+
+	newHasNAD := vca.clusterConfig.HasNetworkAttachmentDefinitionAPI()
+	if newHasNAD != vca.hasNAD {
+		if newHasNAD {
+			log.Log.Infof("Reinitialize virt-controller, network-attachment-definition api has been introduced")
+		} else {
+			log.Log.Infof("Reinitialize virt-controller, network-attachment-definition api has been removed")
+		}
+		vca.reInitChan <- "reinit"
 	}
 }
 
@@ -616,6 +637,7 @@ func (vca *VirtControllerApp) initCommon() {
 		vca.cdiConfigInformer,
 		vca.clusterConfig,
 		topologyHinter,
+		vca.migrationInformer,
 	)
 	if err != nil {
 		panic(err)
@@ -691,6 +713,7 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 		vca.persistentVolumeClaimInformer,
 		vca.controllerRevisionInformer,
 		vca.kvPodInformer,
+		vca.nadInformer,
 		instancetypeMethods,
 		recorder,
 		vca.clientSet,
@@ -636,7 +636,8 @@ func setTargetPodSELinuxLevel(pod *k8sv1.Pod, vmiSeContext string) error {
 }
 
 func (c *MigrationController) createTargetPod(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, sourcePod *k8sv1.Pod) error {
-	templatePod, err := c.templateService.RenderMigrationManifest(vmi, sourcePod)
+	_, _, plugsInterfaces := calculateDynamicInterfaces(vmi)
+	templatePod, err := c.templateService.RenderMigrationManifest(vmi, sourcePod, plugsInterfaces)
 	if err != nil {
 		return fmt.Errorf("failed to render launch manifest: %v", err)
 	}
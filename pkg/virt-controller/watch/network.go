@@ -19,9 +19,26 @@
 package watch
 
 import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 )
 
 func calculateDynamicInterfaces(vmi *v1.VirtualMachineInstance) ([]v1.Interface, []v1.Network, bool) {
@@ -48,13 +65,21 @@ func applyDynamicIfaceRequestOnVMI(vm *v1.VirtualMachine, vmi *v1.VirtualMachine
 	vmiIndexedInterfaces := vmispec.IndexInterfaceSpecByName(vmiSpecCopy.Domain.Devices.Interfaces)
 	vmIndexedNetworks := vmispec.IndexNetworkSpecByName(vm.Spec.Template.Spec.Networks)
 	for _, vmIface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
-		_, existsInVMISpec := vmiIndexedInterfaces[vmIface.Name]
+		vmiIface, existsInVMISpec := vmiIndexedInterfaces[vmIface.Name]
 		shouldBeHotPlug := !existsInVMISpec && vmIface.State != v1.InterfaceStateAbsent && vmIface.InterfaceBindingMethod.Bridge != nil
 		shouldBeHotUnplug := !hasOrdinalIfaces && existsInVMISpec && vmIface.State == v1.InterfaceStateAbsent
+		shouldBeReplugged := existsInVMISpec && vmiIface.State == v1.InterfaceStateAbsent &&
+			vmIface.State != v1.InterfaceStateAbsent && vmIface.InterfaceBindingMethod.Bridge != nil &&
+			vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, vmIface.Name) == nil
 		if shouldBeHotPlug {
 			vmiSpecCopy.Networks = append(vmiSpecCopy.Networks, vmIndexedNetworks[vmIface.Name])
 			vmiSpecCopy.Domain.Devices.Interfaces = append(vmiSpecCopy.Domain.Devices.Interfaces, vmIface)
 		}
+		if shouldBeReplugged {
+			// The interface was unplugged and is requested again under the same name
+			*vmispec.LookupNetworkByName(vmiSpecCopy.Networks, vmIface.Name) = vmIndexedNetworks[vmIface.Name]
+			*vmispec.LookupInterfaceByName(vmiSpecCopy.Domain.Devices.Interfaces, vmIface.Name) = vmIface
+		}
 		if shouldBeHotUnplug {
 			vmiIface := vmispec.LookupInterfaceByName(vmiSpecCopy.Domain.Devices.Interfaces, vmIface.Name)
 			vmiIface.State = v1.InterfaceStateAbsent
@@ -62,3 +87,254 @@ func applyDynamicIfaceRequestOnVMI(vm *v1.VirtualMachine, vmi *v1.VirtualMachine
 	}
 	return vmiSpecCopy
 }
+
+// retainMACsOfUnpluggedInterfaces sets the MAC address reported by the VMI status on the given VM interfaces
+// which are marked for removal and have no MAC address specified, so that it is reused once an interface
+// with the same name is plugged again.
+func retainMACsOfUnpluggedInterfaces(vmIfaces []v1.Interface, vmi *v1.VirtualMachineInstance) {
+	for idx := range vmIfaces {
+		iface := &vmIfaces[idx]
+		if iface.State != v1.InterfaceStateAbsent || iface.MacAddress != "" {
+			continue
+		}
+		if ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, iface.Name); ifaceStatus != nil {
+			iface.MacAddress = ifaceStatus.MAC
+		}
+	}
+}
+
+// dynamicIfaceChangesAppliedOnVMI reports whether the VM template differs from the given revision template
+// only by interface hotplug/unplug requests, and all of them are already reflected on the VMI spec.
+func dynamicIfaceChangesAppliedOnVMI(
+	revisionTemplate *v1.VirtualMachineInstanceTemplateSpec,
+	vm *v1.VirtualMachine,
+	vmi *v1.VirtualMachineInstance,
+	hasOrdinalIfaces bool,
+) bool {
+	if revisionTemplate == nil || vm.Spec.Template == nil {
+		return false
+	}
+
+	revisionTemplateWithVMIfaces := revisionTemplate.DeepCopy()
+	revisionTemplateWithVMIfaces.Spec.Networks = vm.Spec.Template.Spec.Networks
+	revisionTemplateWithVMIfaces.Spec.Domain.Devices.Interfaces = vm.Spec.Template.Spec.Domain.Devices.Interfaces
+	if !equality.Semantic.DeepEqual(revisionTemplateWithVMIfaces, vm.Spec.Template) {
+		return false
+	}
+
+	if !onlyDynamicIfaceChanges(&revisionTemplate.Spec, &vm.Spec.Template.Spec, vmi) {
+		return false
+	}
+
+	desiredVMISpec := applyDynamicIfaceRequestOnVMI(vm, vmi, hasOrdinalIfaces)
+	return equality.Semantic.DeepEqual(desiredVMISpec.Networks, vmi.Spec.Networks) &&
+		equality.Semantic.DeepEqual(desiredVMISpec.Domain.Devices.Interfaces, vmi.Spec.Domain.Devices.Interfaces) &&
+		ifaceStatesReflectedOnVMI(vm.Spec.Template.Spec.Domain.Devices.Interfaces, vmi)
+}
+
+// ifaceStatesReflectedOnVMI reports whether the state of every VM interface is reflected on the VMI spec,
+// as requests which are not supported, e.g. unplugging interfaces with ordinal names, are not applied.
+func ifaceStatesReflectedOnVMI(vmIfaces []v1.Interface, vmi *v1.VirtualMachineInstance) bool {
+	for _, vmIface := range vmIfaces {
+		vmiIface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, vmIface.Name)
+		if vmiIface == nil && vmIface.State != v1.InterfaceStateAbsent {
+			return false
+		}
+		if vmiIface != nil && vmiIface.State != vmIface.State {
+			return false
+		}
+	}
+	return true
+}
+
+// onlyDynamicIfaceChanges reports whether the VM interfaces and networks differ from the revision ones only
+// by appended interface/network pairs and by interfaces marked for removal.
+// An existing entry may also equal the VMI one, as an unplugged interface which is plugged again is applied
+// on the VMI as is.
+func onlyDynamicIfaceChanges(revisionSpec, vmSpec *v1.VirtualMachineInstanceSpec, vmi *v1.VirtualMachineInstance) bool {
+	revisionIfaces, vmIfaces := revisionSpec.Domain.Devices.Interfaces, vmSpec.Domain.Devices.Interfaces
+	revisionNets, vmNets := revisionSpec.Networks, vmSpec.Networks
+	if len(vmIfaces) < len(revisionIfaces) || len(vmNets) < len(revisionNets) {
+		return false
+	}
+
+	for idx, revisionIface := range revisionIfaces {
+		vmIface := vmIfaces[idx]
+		vmiIface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, vmIface.Name)
+		isAppliedOnVMI := vmiIface != nil && equality.Semantic.DeepEqual(*vmiIface, vmIface)
+		if !isAppliedOnVMI && !equality.Semantic.DeepEqual(revisionIface, withoutUnplugChanges(vmIface, revisionIface)) {
+			return false
+		}
+	}
+	for idx, revisionNet := range revisionNets {
+		vmNet := vmNets[idx]
+		vmiNet := vmispec.LookupNetworkByName(vmi.Spec.Networks, vmNet.Name)
+		isAppliedOnVMI := vmiNet != nil && equality.Semantic.DeepEqual(*vmiNet, vmNet)
+		if !isAppliedOnVMI && !equality.Semantic.DeepEqual(revisionNet, vmNet) {
+			return false
+		}
+	}
+
+	appendedIfaces, appendedNets := vmIfaces[len(revisionIfaces):], vmNets[len(revisionNets):]
+	if len(appendedIfaces) != len(appendedNets) {
+		return false
+	}
+	for idx := range appendedIfaces {
+		if appendedIfaces[idx].Name != appendedNets[idx].Name {
+			return false
+		}
+	}
+	return true
+}
+
+// withoutUnplugChanges returns the given VM interface without the changes made by unplugging it:
+// its state, and the MAC address which may be retained for a later re-plug.
+func withoutUnplugChanges(vmIface, revisionIface v1.Interface) v1.Interface {
+	if vmIface.State != v1.InterfaceStateAbsent {
+		return vmIface
+	}
+	vmIface.State = revisionIface.State
+	if revisionIface.MacAddress == "" {
+		vmIface.MacAddress = ""
+	}
+	return vmIface
+}
+
+// networksMissingFromPod reports whether any of the given secondary networks is not reported
+// by the pod network status, i.e. it was not plugged into the pod yet.
+func networksMissingFromPod(networks []v1.Network, pod *k8sv1.Pod) bool {
+	return len(networksNotPluggedIntoPod(networks, pod)) > 0
+}
+
+// networksNotPluggedIntoPod returns the names of the given secondary networks which are not reported
+// by the pod network status, i.e. which were not plugged into the pod yet.
+func networksNotPluggedIntoPod(networks []v1.Network, pod *k8sv1.Pod) []string {
+	indexedMultusStatusIfaces := services.NonDefaultMultusNetworksIndexedByIfaceName(pod)
+	networkToPodIfaceMap := namescheme.CreateNetworkNameSchemeByPodNetworkStatus(networks, indexedMultusStatusIfaces)
+	var networkNames []string
+	for _, network := range vmispec.FilterMultusNonDefaultNetworks(networks) {
+		if _, exists := indexedMultusStatusIfaces[networkToPodIfaceMap[network.Name]]; !exists {
+			networkNames = append(networkNames, network.Name)
+		}
+	}
+	return networkNames
+}
+
+// inPlaceHotplugPendingSinceAnnotation records on the pod since when it waits for hotplugged networks
+// to be plugged into it in place, before the vmi is migrated to plug them.
+const inPlaceHotplugPendingSinceAnnotation = "kubevirt.io/in-place-hotplug-pending-since"
+
+// inPlaceHotplugPendingSince returns the time the pod started waiting for hotplugged networks,
+// and whether it is recorded on the pod.
+func inPlaceHotplugPendingSince(pod *k8sv1.Pod) (time.Time, bool) {
+	pendingSince, err := time.Parse(time.RFC3339, pod.Annotations[inPlaceHotplugPendingSinceAnnotation])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return pendingSince, true
+}
+
+// networksWithDeletedNetworkAttachmentDefinition returns the names of the given secondary networks
+// whose NetworkAttachmentDefinition is not found in the given NetworkAttachmentDefinition store.
+// Callers pass the networks which are still attached, as their NetworkAttachmentDefinition
+// existed when they were plugged.
+func networksWithDeletedNetworkAttachmentDefinition(namespace string, networks []v1.Network, nadStore cache.Store) []string {
+	var deletedNetworks []string
+	for _, network := range vmispec.FilterMultusNonDefaultNetworks(networks) {
+		_, exists, err := nadStore.GetByKey(controller.NetworkAttachmentDefinitionKey(namespace, network.Multus.NetworkName))
+		if err == nil && !exists {
+			deletedNetworks = append(deletedNetworks, network.Name)
+		}
+	}
+	return deletedNetworks
+}
+
+// cniResult is the part of a CNI result, as reported by the pod network status, which is recorded for
+// debugging. The DNS configuration and the device information are left out, as they may expose details
+// of the node.
+type cniResult struct {
+	Interface string   `json:"interface,omitempty"`
+	IPs       []string `json:"ips,omitempty"`
+	Mac       string   `json:"mac,omitempty"`
+}
+
+func sanitizedCNIResult(networkStatus networkv1.NetworkStatus) string {
+	result, err := json.Marshal(cniResult{
+		Interface: networkStatus.Interface,
+		IPs:       networkStatus.IPs,
+		Mac:       networkStatus.Mac,
+	})
+	if err != nil {
+		return ""
+	}
+	return string(result)
+}
+
+// podSandboxCreationFailedReason is the reason of the kubelet events reporting a failure to create
+// the sandbox of a pod, e.g. when the CNI ADD of one of its networks fails.
+const podSandboxCreationFailedReason = "FailedCreatePodSandBox"
+
+// cniFailuresPollInterval is the interval the sandbox creation failures of the pending pods of a vmi are
+// looked up at, while some of its network interfaces wait to be plugged.
+const cniFailuresPollInterval = 10 * time.Second
+
+// maxRecordedMessageLength is the length in bytes a pod event message is cut to, when it is recorded
+// for debugging.
+const maxRecordedMessageLength = 512
+
+// printableTruncatedMessage strips the control characters of the message and cuts it to
+// maxRecordedMessageLength bytes, on a rune boundary.
+func printableTruncatedMessage(message string) string {
+	var truncated strings.Builder
+	for _, r := range message {
+		if unicode.IsControl(r) {
+			continue
+		}
+		if truncated.Len()+utf8.RuneLen(r) > maxRecordedMessageLength {
+			return truncated.String() + "..."
+		}
+		truncated.WriteRune(r)
+	}
+	return truncated.String()
+}
+
+// podEventTime returns the last time the pod event happened.
+func podEventTime(podEvent k8sv1.Event) time.Time {
+	switch {
+	case !podEvent.LastTimestamp.IsZero():
+		return podEvent.LastTimestamp.Time
+	case !podEvent.EventTime.IsZero():
+		return podEvent.EventTime.Time
+	default:
+		return podEvent.CreationTimestamp.Time
+	}
+}
+
+// recordedCNIFailures tracks per vmi the time of the last pod event reporting a CNI failure which was
+// recorded on it.
+type recordedCNIFailures struct {
+	lock   sync.Mutex
+	latest map[types.UID]time.Time
+}
+
+func newRecordedCNIFailures() *recordedCNIFailures {
+	return &recordedCNIFailures{latest: map[types.UID]time.Time{}}
+}
+
+// add marks the failure which happened at the given time as recorded on the vmi, reporting whether
+// it happened after the last recorded one.
+func (r *recordedCNIFailures) add(vmiUID types.UID, failureTime time.Time) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if latest, exists := r.latest[vmiUID]; exists && !failureTime.After(latest) {
+		return false
+	}
+	r.latest[vmiUID] = failureTime
+	return true
+}
+
+func (r *recordedCNIFailures) remove(vmiUID types.UID) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.latest, vmiUID)
+}
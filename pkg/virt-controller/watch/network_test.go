@@ -19,6 +19,10 @@
 package watch
 
 import (
+	"encoding/json"
+	"strings"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -26,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -40,6 +45,9 @@ var _ = Describe("Network interface hot{un}plug", func() {
 		testNetworkName1 = "testnet1"
 		testNetworkName2 = "testnet2"
 
+		testMAC      = "02:00:00:00:00:01"
+		testOtherMAC = "02:00:00:00:00:02"
+
 		ordinal = true
 	)
 	DescribeTable("calculate if changes are required",
@@ -219,9 +227,311 @@ var _ = Describe("Network interface hot{un}plug", func() {
 				libvmi.WithNetwork(&v1.Network{Name: testNetworkName2}),
 			),
 			!ordinal),
+		Entry("when an unplugged interface has to be plugged again",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithMAC(testNetworkName1, testMAC)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithMAC(testNetworkName1, testMAC)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal),
+		Entry("when an interface has to be plugged again but its unplug is not completed",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+				withInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{Name: testNetworkName1, MAC: testMAC}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal),
 	)
+	DescribeTable("retain MACs of unplugged interfaces",
+		func(vmIfaces []v1.Interface, vmi *v1.VirtualMachineInstance, expectedIfaces []v1.Interface) {
+			retainMACsOfUnpluggedInterfaces(vmIfaces, vmi)
+			Expect(vmIfaces).To(Equal(expectedIfaces))
+		},
+		Entry("when the unplugged interface is reported by the VMI status",
+			[]v1.Interface{bridgeInterface(testNetworkName1), bridgeAbsentInterface(testNetworkName2)},
+			libvmi.New(
+				withInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{Name: testNetworkName1, MAC: testMAC}),
+				withInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{Name: testNetworkName2, MAC: testOtherMAC}),
+			),
+			[]v1.Interface{bridgeInterface(testNetworkName1), bridgeAbsentInterfaceWithMAC(testNetworkName2, testOtherMAC)},
+		),
+		Entry("when the unplugged interface is no longer reported by the VMI status",
+			[]v1.Interface{bridgeAbsentInterface(testNetworkName1)},
+			libvmi.New(),
+			[]v1.Interface{bridgeAbsentInterface(testNetworkName1)},
+		),
+		Entry("when the unplugged interface already has a MAC address specified",
+			[]v1.Interface{bridgeAbsentInterfaceWithMAC(testNetworkName1, testMAC)},
+			libvmi.New(
+				withInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{Name: testNetworkName1, MAC: testOtherMAC}),
+			),
+			[]v1.Interface{bridgeAbsentInterfaceWithMAC(testNetworkName1, testMAC)},
+		),
+	)
+	DescribeTable("dynamic interface changes applied on VMI",
+		func(revisionVMI, vmiForVM, currentVMI *v1.VirtualMachineInstance, hasOrdinalIfaces, expectApplied bool) {
+			revisionVM := VirtualMachineFromVMI(currentVMI.Name, revisionVMI, true)
+			vm := revisionVM.DeepCopy()
+			vm.Spec.Template.Spec = vmiForVM.Spec
+			Expect(dynamicIfaceChangesAppliedOnVMI(revisionVM.Spec.Template, vm, currentVMI, hasOrdinalIfaces)).To(Equal(expectApplied))
+		},
+		Entry("when a hotplugged interface is reflected on the VMI",
+			libvmi.New(),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal, true),
+		Entry("when a hotplugged interface is not reflected on the VMI yet",
+			libvmi.New(),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(),
+			!ordinal, false),
+		Entry("when a hotunplugged interface is reflected on the VMI",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal, true),
+		Entry("when a hotunplugged interface is not reflected on the VMI yet",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal, false),
+		Entry("when the templates differ by other than interfaces",
+			libvmi.New(),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+				libvmi.WithResourceMemory("1Gi"),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal, false),
+		Entry("when an interface is unplugged from a VMI with ordinal interface names",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			ordinal, false),
+		Entry("when an unplugged interface retains its MAC address",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterfaceWithMAC(testNetworkName1, testMAC)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal, true),
+		Entry("when an unplugged interface is plugged again",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithMAC(testNetworkName1, testMAC)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithMAC(testNetworkName1, testMAC)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal, true),
+		Entry("when the MAC address of an existing interface is changed",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithMAC(testNetworkName1, testMAC)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal, false),
+		Entry("when the model of an existing interface is changed",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(withModel(bridgeInterface(testNetworkName1), "e1000")),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal, false),
+		Entry("when the binding of an existing interface is changed",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(v1.Interface{
+					Name:                   testNetworkName1,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				}),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal, false),
+		Entry("when the network of an existing interface is changed",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(libvmi.MultusNetwork(testNetworkName1, "red-net")),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(libvmi.MultusNetwork(testNetworkName1, "blue-net")),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(libvmi.MultusNetwork(testNetworkName1, "red-net")),
+			),
+			!ordinal, false),
+	)
+	DescribeTable("networks missing from pod",
+		func(podNetworkStatus []networkv1.NetworkStatus, expectMissing bool) {
+			networks := []v1.Network{
+				*v1.DefaultPodNetwork(),
+				{Name: testNetworkName1, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+			}
+			pod := &k8sv1.Pod{}
+			if len(podNetworkStatus) > 0 {
+				podNetworkStatusJSON, err := json.Marshal(podNetworkStatus)
+				Expect(err).NotTo(HaveOccurred())
+				pod.Annotations = map[string]string{networkv1.NetworkStatusAnnot: string(podNetworkStatusJSON)}
+			}
+			Expect(networksMissingFromPod(networks, pod)).To(Equal(expectMissing))
+		},
+		Entry("when the pod has no network status", nil, true),
+		Entry("when the pod network status reports only the primary network",
+			[]networkv1.NetworkStatus{{Interface: "eth0", Name: "k8s-pod-network", Default: true}},
+			true),
+		Entry("when the pod network status reports the secondary network",
+			[]networkv1.NetworkStatus{
+				{Interface: "eth0", Name: "k8s-pod-network", Default: true},
+				{Interface: "net1", Name: "red-net"},
+			},
+			false),
+	)
+	DescribeTable("networks with a deleted network-attachment-definition",
+		func(existingNADKeys []string, expectedNetworks []string) {
+			networks := []v1.Network{
+				*v1.DefaultPodNetwork(),
+				{Name: testNetworkName1, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+				{Name: testNetworkName2, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "other-ns/blue-net"}}},
+			}
+			nadStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			for _, key := range existingNADKeys {
+				namespace, name, err := cache.SplitMetaNamespaceKey(key)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nadStore.Add(newNetworkAttachmentDefinition(namespace, name))).To(Succeed())
+			}
+			Expect(networksWithDeletedNetworkAttachmentDefinition(metav1.NamespaceDefault, networks, nadStore)).To(Equal(expectedNetworks))
+		},
+		Entry("when all network-attachment-definitions exist",
+			[]string{metav1.NamespaceDefault + "/red-net", "other-ns/blue-net"}, nil),
+		Entry("when the network-attachment-definition of the VM namespace is deleted",
+			[]string{"other-ns/blue-net"}, []string{testNetworkName1}),
+		Entry("when the network-attachment-definition of another namespace is deleted",
+			[]string{metav1.NamespaceDefault + "/red-net"}, []string{testNetworkName2}),
+		Entry("when only a network-attachment-definition of the same name in another namespace exists",
+			[]string{"other-ns/red-net", "other-ns/blue-net"}, []string{testNetworkName1}),
+		Entry("when all network-attachment-definitions are deleted",
+			nil, []string{testNetworkName1, testNetworkName2}),
+	)
+
+	DescribeTable("printable truncated message",
+		func(message, expectedMessage string) {
+			Expect(printableTruncatedMessage(message)).To(Equal(expectedMessage))
+		},
+		Entry("when the message is short", "CNI request failed", "CNI request failed"),
+		Entry("when the message is long",
+			strings.Repeat("x", maxRecordedMessageLength+1), strings.Repeat("x", maxRecordedMessageLength)+"..."),
+		Entry("when the message is cut within a rune",
+			strings.Repeat("x", maxRecordedMessageLength-1)+"é", strings.Repeat("x", maxRecordedMessageLength-1)+"..."),
+		Entry("when the message has control characters", "CNI request\n\tfailed\x1b[0m", "CNI requestfailed[0m"),
+	)
+
+	It("should record a CNI failure per vmi once, unless it happened after the recorded one", func() {
+		failures := newRecordedCNIFailures()
+		failureTime := time.Now()
+		Expect(failures.add("vmi-uid", failureTime)).To(BeTrue())
+		Expect(failures.add("vmi-uid", failureTime)).To(BeFalse())
+		Expect(failures.add("vmi-uid", failureTime.Add(-time.Second))).To(BeFalse())
+		Expect(failures.add("other-vmi-uid", failureTime)).To(BeTrue())
+		Expect(failures.add("vmi-uid", failureTime.Add(time.Second))).To(BeTrue())
+		failures.remove("vmi-uid")
+		Expect(failures.add("vmi-uid", failureTime)).To(BeTrue())
+	})
 })
 
+func newNetworkAttachmentDefinition(namespace, name string) *networkv1.NetworkAttachmentDefinition {
+	return &networkv1.NetworkAttachmentDefinition{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
 func bridgeInterface(name string) v1.Interface {
 	return v1.Interface{Name: name, InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}}
 }
@@ -232,6 +542,23 @@ func bridgeAbsentInterface(name string) v1.Interface {
 	return iface
 }
 
+func bridgeInterfaceWithMAC(name, macAddress string) v1.Interface {
+	iface := bridgeInterface(name)
+	iface.MacAddress = macAddress
+	return iface
+}
+
+func withModel(iface v1.Interface, model string) v1.Interface {
+	iface.Model = model
+	return iface
+}
+
+func bridgeAbsentInterfaceWithMAC(name, macAddress string) v1.Interface {
+	iface := bridgeAbsentInterface(name)
+	iface.MacAddress = macAddress
+	return iface
+}
+
 func withInterfaceStatus(ifaceStatus v1.VirtualMachineInstanceNetworkInterface) libvmi.Option {
 	return func(vmi *v1.VirtualMachineInstance) {
 		vmi.Status.Interfaces = append(
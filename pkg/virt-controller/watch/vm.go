@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 
 	"kubevirt.io/kubevirt/pkg/util/hardware"
@@ -38,6 +39,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
 
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	"github.com/pborman/uuid"
 	appsv1 "k8s.io/api/apps/v1"
 	authv1 "k8s.io/api/authorization/v1"
@@ -111,6 +113,13 @@ const (
 	FailedCreateReason                 = "FailedCreate"
 	VMIFailedDeleteReason              = "FailedDelete"
 	HotPlugNetworkInterfaceErrorReason = "HotPlugNetworkInterfaceError"
+
+	// NetworkAttachmentDefinitionDeletedReason is set on the NetworkAttachmentDefinitionMissing
+	// condition when a NetworkAttachmentDefinition backing an attached interface no longer exists.
+	NetworkAttachmentDefinitionDeletedReason = "NetworkAttachmentDefinitionDeleted"
+	// UnplugInterfaceOfDeletedNetworkReason is added in an event when an interface is marked for
+	// removal because its NetworkAttachmentDefinition was deleted.
+	UnplugInterfaceOfDeletedNetworkReason = "UnplugInterfaceOfDeletedNetwork"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -121,6 +130,7 @@ func NewVMController(vmiInformer cache.SharedIndexInformer,
 	pvcInformer cache.SharedIndexInformer,
 	crInformer cache.SharedIndexInformer,
 	podInformer cache.SharedIndexInformer,
+	nadInformer cache.SharedIndexInformer,
 	instancetypeMethods instancetype.Methods,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
@@ -136,6 +146,7 @@ func NewVMController(vmiInformer cache.SharedIndexInformer,
 		pvcInformer:            pvcInformer,
 		crInformer:             crInformer,
 		podInformer:            podInformer,
+		nadInformer:            nadInformer,
 		instancetypeMethods:    instancetypeMethods,
 		recorder:               recorder,
 		clientset:              clientset,
@@ -146,6 +157,7 @@ func NewVMController(vmiInformer cache.SharedIndexInformer,
 		},
 		statusUpdater: status.NewVMStatusUpdater(clientset),
 		clusterConfig: clusterConfig,
+		hasNAD:        clusterConfig.HasNetworkAttachmentDefinitionAPI(),
 	}
 
 	_, err := c.vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -175,6 +187,14 @@ func NewVMController(vmiInformer cache.SharedIndexInformer,
 		return nil, err
 	}
 
+	_, err = c.nadInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addNetworkAttachmentDefinition,
+		DeleteFunc: c.deleteNetworkAttachmentDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return c, nil
 }
 
@@ -195,6 +215,7 @@ type VMController struct {
 	pvcInformer            cache.SharedIndexInformer
 	crInformer             cache.SharedIndexInformer
 	podInformer            cache.SharedIndexInformer
+	nadInformer            cache.SharedIndexInformer
 	instancetypeMethods    instancetype.Methods
 	recorder               record.EventRecorder
 	expectations           *controller.UIDTrackingControllerExpectations
@@ -202,6 +223,9 @@ type VMController struct {
 	cloneAuthFunc          CloneAuthFunc
 	statusUpdater          *status.VMStatusUpdater
 	clusterConfig          *virtconfig.ClusterConfig
+	// hasNAD tells whether nadInformer watches the NetworkAttachmentDefinitions, the controller is
+	// recreated when the API is introduced or removed.
+	hasNAD bool
 }
 
 func (c *VMController) Run(threadiness int, stopCh <-chan struct{}) {
@@ -210,7 +234,7 @@ func (c *VMController) Run(threadiness int, stopCh <-chan struct{}) {
 	log.Log.Info("Starting VirtualMachine controller.")
 
 	// Wait for cache sync before we start the controller
-	cache.WaitForCacheSync(stopCh, c.vmiInformer.HasSynced, c.vmInformer.HasSynced, c.dataVolumeInformer.HasSynced, c.podInformer.HasSynced)
+	cache.WaitForCacheSync(stopCh, c.vmiInformer.HasSynced, c.vmInformer.HasSynced, c.dataVolumeInformer.HasSynced, c.podInformer.HasSynced, c.nadInformer.HasSynced)
 
 	// Start the actual work
 	for i := 0; i < threadiness; i++ {
@@ -1113,6 +1137,8 @@ type VirtualMachineRevisionData struct {
 //
 // Note that if only the Run Strategy of the VM has changed, the generaiton
 // annotation will still be bumped, since this does not affect the VMI.
+// The same applies to interface hotplug/unplug requests, once they are
+// reflected on the VMI spec.
 func (c *VMController) conditionallyBumpGenerationAnnotationOnVmi(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vm == nil {
 		return nil
@@ -1140,7 +1166,16 @@ func (c *VMController) conditionallyBumpGenerationAnnotationOnVmi(vm *virtv1.Vir
 	}
 
 	// If the templates are the same, we can safely bump the annotation.
-	if equality.Semantic.DeepEqual(revisionSpec.Spec.Template, vm.Spec.Template) {
+	// The same applies when they differ only by interface hotplug requests which are applied.
+	shouldBumpGeneration := equality.Semantic.DeepEqual(revisionSpec.Spec.Template, vm.Spec.Template)
+	if !shouldBumpGeneration && c.clusterConfig.HotplugNetworkInterfacesEnabled() {
+		hasOrdinalIfaces, err := c.hasOrdinalNetworkInterfaces(vmi)
+		if err != nil {
+			return err
+		}
+		shouldBumpGeneration = dynamicIfaceChangesAppliedOnVMI(revisionSpec.Spec.Template, vm, vmi, hasOrdinalIfaces)
+	}
+	if shouldBumpGeneration {
 		if err := c.patchVmGenerationAnnotationOnVmi(vm.Generation, vmi); err != nil {
 			return err
 		}
@@ -1880,6 +1915,46 @@ func (c *VMController) queueVMsForDataVolume(dataVolume *cdiv1.DataVolume) {
 	}
 }
 
+func (c *VMController) addNetworkAttachmentDefinition(obj interface{}) {
+	nad := obj.(*networkv1.NetworkAttachmentDefinition)
+	c.queueVMsForNetworkAttachmentDefinition(nad)
+}
+
+func (c *VMController) deleteNetworkAttachmentDefinition(obj interface{}) {
+	nad, ok := obj.(*networkv1.NetworkAttachmentDefinition)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error(failedProcessDeleteNotificationErrMsg)
+			return
+		}
+		nad, ok = tombstone.Obj.(*networkv1.NetworkAttachmentDefinition)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a NetworkAttachmentDefinition %#v", obj)).Error(failedProcessDeleteNotificationErrMsg)
+			return
+		}
+	}
+	c.queueVMsForNetworkAttachmentDefinition(nad)
+}
+
+func (c *VMController) queueVMsForNetworkAttachmentDefinition(nad *networkv1.NetworkAttachmentDefinition) {
+	k, err := controller.KeyFunc(nad)
+	if err != nil {
+		log.Log.Object(nad).Errorf("Cannot parse key of NetworkAttachmentDefinition: %s", nad.Name)
+		return
+	}
+	objs, err := c.vmInformer.GetIndexer().ByIndex("nad", k)
+	if err != nil {
+		log.Log.Object(nad).Errorf("Cannot get index nad of NetworkAttachmentDefinition: %s", nad.Name)
+		return
+	}
+	for _, obj := range objs {
+		vm := obj.(*virtv1.VirtualMachine)
+		log.Log.V(4).Object(nad).Infof("NetworkAttachmentDefinition changed for vm %s", vm.Name)
+		c.enqueueVm(vm)
+	}
+}
+
 func (c *VMController) addVirtualMachine(obj interface{}) {
 	c.enqueueVm(obj)
 }
@@ -2311,6 +2386,7 @@ func (c *VMController) syncConditions(vm *virtv1.VirtualMachine, vmi *virtv1.Vir
 	// ready condition is handled differently as it persists regardless if vmi exists or not
 	c.syncReadyConditionFromVMI(vm, vmi)
 	c.processFailureCondition(vm, vmi, syncErr)
+	c.syncNetworkAttachmentDefinitionMissingCondition(vm, vmi)
 
 	// nothing to do if vmi hasn't been created yet.
 	if vmi == nil {
@@ -2319,8 +2395,9 @@ func (c *VMController) syncConditions(vm *virtv1.VirtualMachine, vmi *virtv1.Vir
 
 	// sync VMI conditions, ignore list represents conditions that are not synced generically
 	syncIgnoreMap := map[string]interface{}{
-		string(virtv1.VirtualMachineReady):   nil,
-		string(virtv1.VirtualMachineFailure): nil,
+		string(virtv1.VirtualMachineReady):                              nil,
+		string(virtv1.VirtualMachineFailure):                            nil,
+		string(virtv1.VirtualMachineNetworkAttachmentDefinitionMissing): nil,
 	}
 	vmiCondMap := make(map[string]interface{})
 
@@ -2355,6 +2432,48 @@ func (c *VMController) syncConditions(vm *virtv1.VirtualMachine, vmi *virtv1.Vir
 	}
 }
 
+// syncNetworkAttachmentDefinitionMissingCondition reports the VMI interfaces which are still attached
+// while the NetworkAttachmentDefinition of their network has been deleted.
+func (c *VMController) syncNetworkAttachmentDefinitionMissingCondition(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	cm := controller.NewVirtualMachineConditionManager()
+	if vmi == nil {
+		cm.RemoveCondition(vm, virtv1.VirtualMachineNetworkAttachmentDefinitionMissing)
+		return
+	}
+
+	deletedNetworks := c.attachedNetworksWithDeletedNetworkAttachmentDefinition(vmi)
+	if len(deletedNetworks) == 0 {
+		cm.RemoveCondition(vm, virtv1.VirtualMachineNetworkAttachmentDefinitionMissing)
+		return
+	}
+
+	message := fmt.Sprintf("NetworkAttachmentDefinition of network(s) %s not found", strings.Join(deletedNetworks, ", "))
+	if cond := cm.GetCondition(vm, virtv1.VirtualMachineNetworkAttachmentDefinitionMissing); cond != nil && cond.Message == message {
+		return
+	}
+	cm.RemoveCondition(vm, virtv1.VirtualMachineNetworkAttachmentDefinitionMissing)
+	cm.UpdateCondition(vm, &virtv1.VirtualMachineCondition{
+		Type:               virtv1.VirtualMachineNetworkAttachmentDefinitionMissing,
+		Status:             k8score.ConditionTrue,
+		Reason:             NetworkAttachmentDefinitionDeletedReason,
+		Message:            message,
+		LastTransitionTime: v1.Now(),
+	})
+}
+
+// attachedNetworksWithDeletedNetworkAttachmentDefinition returns the names of the secondary networks
+// which are still attached to the VMI, while their NetworkAttachmentDefinition no longer exists.
+func (c *VMController) attachedNetworksWithDeletedNetworkAttachmentDefinition(vmi *virtv1.VirtualMachineInstance) []string {
+	if !c.hasNAD {
+		return nil
+	}
+	attachedNetworks := vmispec.FilterNetworksSpec(vmi.Spec.Networks, func(network virtv1.Network) bool {
+		return vmispec.IsSecondaryMultusNetwork(network) &&
+			vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, network.Name) != nil
+	})
+	return networksWithDeletedNetworkAttachmentDefinition(vmi.Namespace, attachedNetworks, c.nadInformer.GetStore())
+}
+
 func (c *VMController) processFailureCondition(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, syncErr syncError) {
 
 	vmConditionManager := controller.NewVirtualMachineConditionManager()
@@ -2616,6 +2735,10 @@ func (c *VMController) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 	if c.needsSync(key) && syncErr == nil {
 		vmCopy := vm.DeepCopy()
 		if c.clusterConfig.HotplugNetworkInterfacesEnabled() {
+			if err = reconcileDesiredInterfaces(vmCopy); err != nil {
+				syncErr = &syncErrorImpl{fmt.Errorf("Error encountered when trying to reconcile the desired interfaces: %v", err), HotPlugNetworkInterfaceErrorReason}
+			}
+			c.unplugInterfacesOfDeletedNetworks(vmCopy, vmi)
 			if err = c.handleDynamicIfaceRequestOnVMI(vmCopy, vmi); err != nil {
 				syncErr = &syncErrorImpl{fmt.Errorf("Error encountered when trying to apply interface request on vmi: %v", err), HotPlugNetworkInterfaceErrorReason}
 			}
@@ -2660,11 +2783,52 @@ func (c *VMController) handleDynamicIfaceRequestOnVMI(vm *virtv1.VirtualMachine,
 		return err
 	}
 
+	if c.clusterConfig.IsRetainMACOnUnplugEnabled() {
+		retainMACsOfUnpluggedInterfaces(vm.Spec.Template.Spec.Domain.Devices.Interfaces, vmi)
+	}
+
 	updatedVmiSpec := applyDynamicIfaceRequestOnVMI(vm, vmi, hasOrdinalIfaces)
 
 	return c.vmiInterfacesPatch(updatedVmiSpec, vmi)
 }
 
+// reconcileDesiredInterfaces updates the interfaces of the VM to match the secondary interfaces declared
+// by an external controller in the desired interfaces annotation, if the VM has one.
+func reconcileDesiredInterfaces(vm *virtv1.VirtualMachine) error {
+	desiredIfacesJSON, exists := vm.Annotations[virtv1.DesiredInterfacesAnnotation]
+	if !exists {
+		return nil
+	}
+
+	var desiredIfaces []vmispec.DesiredInterface
+	if err := json.Unmarshal([]byte(desiredIfacesJSON), &desiredIfaces); err != nil {
+		return fmt.Errorf("failed to parse the %s annotation: %v", virtv1.DesiredInterfacesAnnotation, err)
+	}
+
+	spec := &vm.Spec.Template.Spec
+	spec.Domain.Devices.Interfaces, spec.Networks = vmispec.ReconcileDesiredInterfaces(spec, desiredIfaces)
+	return nil
+}
+
+// unplugInterfacesOfDeletedNetworks marks the interfaces of the running VM, which are backed by a
+// NetworkAttachmentDefinition that no longer exists, for removal.
+func (c *VMController) unplugInterfacesOfDeletedNetworks(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	if vmi == nil || vmi.DeletionTimestamp != nil || !c.clusterConfig.IsUnplugInterfacesOfDeletedNetworksEnabled() {
+		return
+	}
+
+	vmIfaces := vm.Spec.Template.Spec.Domain.Devices.Interfaces
+	for _, networkName := range c.attachedNetworksWithDeletedNetworkAttachmentDefinition(vmi) {
+		iface := vmispec.LookupInterfaceByName(vmIfaces, networkName)
+		if iface == nil || iface.State == virtv1.InterfaceStateAbsent {
+			continue
+		}
+		iface.State = virtv1.InterfaceStateAbsent
+		c.recorder.Eventf(vm, k8score.EventTypeNormal, UnplugInterfaceOfDeletedNetworkReason,
+			"Interface %s marked for removal, its NetworkAttachmentDefinition was deleted", networkName)
+	}
+}
+
 // resolveControllerRef returns the controller referenced by a ControllerRef,
 // or nil if the ControllerRef could not be resolved to a matching controller
 // of the correct Kind.
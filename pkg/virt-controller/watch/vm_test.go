@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/pborman/uuid"
@@ -42,6 +43,7 @@ import (
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	kvpointer "kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -69,6 +71,7 @@ var _ = Describe("VirtualMachine", func() {
 		var pvcInformer cache.SharedIndexInformer
 		var crInformer cache.SharedIndexInformer
 		var podInformer cache.SharedIndexInformer
+		var nadInformer cache.SharedIndexInformer
 		var instancetypeMethods *testutils.MockInstancetypeMethods
 		var stop chan struct{}
 		var controller *VMController
@@ -81,6 +84,7 @@ var _ = Describe("VirtualMachine", func() {
 		var virtClient *kubecli.MockKubevirtClient
 		var config *virtconfig.ClusterConfig
 		var kvInformer cache.SharedIndexInformer
+		var crdInformer cache.SharedIndexInformer
 
 		syncCaches := func(stop chan struct{}) {
 			go vmiInformer.Run(stop)
@@ -121,13 +125,14 @@ var _ = Describe("VirtualMachine", func() {
 				},
 			})
 			podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+			nadInformer, _ = testutils.NewFakeInformerFor(&networkv1.NetworkAttachmentDefinition{})
 
 			instancetypeMethods = testutils.NewMockInstancetypeMethods()
 
 			recorder = record.NewFakeRecorder(100)
 			recorder.IncludeObject = true
 
-			config, _, kvInformer = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			config, crdInformer, kvInformer = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
 
 			controller, _ = NewVMController(vmiInformer,
 				vmInformer,
@@ -135,6 +140,7 @@ var _ = Describe("VirtualMachine", func() {
 				pvcInformer,
 				crInformer,
 				podInformer,
+				nadInformer,
 				instancetypeMethods,
 				recorder,
 				virtClient,
@@ -2380,6 +2386,351 @@ var _ = Describe("VirtualMachine", func() {
 			controller.Execute()
 		})
 
+		Context("with a deleted network-attachment-definition", func() {
+			const (
+				networkName = "red"
+				nadName     = "red-net"
+			)
+
+			withSecondaryNetwork := func(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+				network := virtv1.Network{
+					Name:          networkName,
+					NetworkSource: virtv1.NetworkSource{Multus: &virtv1.MultusNetwork{NetworkName: nadName}},
+				}
+				iface := virtv1.Interface{
+					Name:                   networkName,
+					InterfaceBindingMethod: virtv1.InterfaceBindingMethod{Bridge: &virtv1.InterfaceBridge{}},
+				}
+				for _, spec := range []*virtv1.VirtualMachineInstanceSpec{&vm.Spec.Template.Spec, &vmi.Spec} {
+					spec.Networks = append(spec.Networks, network)
+					spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, iface)
+				}
+				vmi.Status.Interfaces = append(vmi.Status.Interfaces, virtv1.VirtualMachineInstanceNetworkInterface{Name: networkName})
+			}
+
+			BeforeEach(func() {
+				controller.hasNAD = true
+			})
+
+			addNetworkAttachmentDefinition := func(namespace string) {
+				Expect(nadInformer.GetStore().Add(newNetworkAttachmentDefinition(namespace, nadName))).To(Succeed())
+			}
+
+			It("should add the NetworkAttachmentDefinitionMissing condition", func() {
+				vm, vmi := DefaultVirtualMachine(true)
+				withSecondaryNetwork(vm, vmi)
+				addVirtualMachine(vm)
+				markAsReady(vmi)
+				vmiFeeder.Add(vmi)
+
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Do(func(ctx context.Context, obj interface{}) {
+					cond := virtcontroller.NewVirtualMachineConditionManager().
+						GetCondition(obj.(*virtv1.VirtualMachine), virtv1.VirtualMachineNetworkAttachmentDefinitionMissing)
+					Expect(cond).ToNot(BeNil())
+					Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+					Expect(cond.Reason).To(Equal(NetworkAttachmentDefinitionDeletedReason))
+					Expect(cond.Message).To(ContainSubstring(networkName))
+				}).Return(vm, nil)
+
+				controller.Execute()
+			})
+
+			It("should remove the NetworkAttachmentDefinitionMissing condition once the network-attachment-definition exists", func() {
+				vm, vmi := DefaultVirtualMachine(true)
+				withSecondaryNetwork(vm, vmi)
+				vm.Status.Conditions = append(vm.Status.Conditions, virtv1.VirtualMachineCondition{
+					Type:   virtv1.VirtualMachineNetworkAttachmentDefinitionMissing,
+					Status: k8sv1.ConditionTrue,
+				})
+				addVirtualMachine(vm)
+				markAsReady(vmi)
+				vmiFeeder.Add(vmi)
+				addNetworkAttachmentDefinition(vm.Namespace)
+
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Do(func(ctx context.Context, obj interface{}) {
+					Expect(virtcontroller.NewVirtualMachineConditionManager().
+						HasCondition(obj.(*virtv1.VirtualMachine), virtv1.VirtualMachineNetworkAttachmentDefinitionMissing)).To(BeFalse())
+				}).Return(vm, nil)
+
+				controller.Execute()
+			})
+
+			enableUnplugInterfacesOfDeletedNetworks := func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &virtv1.KubeVirt{
+					Spec: virtv1.KubeVirtSpec{
+						Configuration: virtv1.KubeVirtConfiguration{
+							DeveloperConfiguration: &virtv1.DeveloperConfiguration{
+								FeatureGates: []string{virtconfig.HotplugNetworkIfacesGate},
+							},
+							NetworkConfiguration: &virtv1.NetworkConfiguration{
+								UnplugInterfacesOfDeletedNetworks: pointer.Bool(true),
+							},
+						},
+					},
+				})
+			}
+
+			It("should mark the interface as absent when unplugging interfaces of deleted networks is enabled", func() {
+				enableUnplugInterfacesOfDeletedNetworks()
+				vm, vmi := DefaultVirtualMachine(true)
+				withSecondaryNetwork(vm, vmi)
+				addVirtualMachine(vm)
+				markAsReady(vmi)
+				vmiFeeder.Add(vmi)
+
+				vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).Return(vmi, nil)
+				vmInterface.EXPECT().Update(context.Background(), gomock.Any()).DoAndReturn(func(ctx context.Context, obj interface{}) (*virtv1.VirtualMachine, error) {
+					updatedVM := obj.(*virtv1.VirtualMachine)
+					iface := vmispec.LookupInterfaceByName(updatedVM.Spec.Template.Spec.Domain.Devices.Interfaces, networkName)
+					Expect(iface).ToNot(BeNil())
+					Expect(iface.State).To(Equal(virtv1.InterfaceStateAbsent))
+					return updatedVM, nil
+				})
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Return(vm, nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, UnplugInterfaceOfDeletedNetworkReason)
+			})
+
+			It("should not mark the interface as absent while its network-attachment-definition exists", func() {
+				enableUnplugInterfacesOfDeletedNetworks()
+				vm, vmi := DefaultVirtualMachine(true)
+				withSecondaryNetwork(vm, vmi)
+				addVirtualMachine(vm)
+				markAsReady(vmi)
+				vmiFeeder.Add(vmi)
+				addNetworkAttachmentDefinition(vm.Namespace)
+
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Do(func(ctx context.Context, obj interface{}) {
+					Expect(virtcontroller.NewVirtualMachineConditionManager().
+						HasCondition(obj.(*virtv1.VirtualMachine), virtv1.VirtualMachineNetworkAttachmentDefinitionMissing)).To(BeFalse())
+				}).Return(vm, nil).AnyTimes()
+
+				controller.Execute()
+			})
+
+			It("should not mark the interface as absent when the network-attachment-definition API was missing at startup", func() {
+				controller.hasNAD = false
+				testutils.AddNetworkAttachmentDefinitionAPI(crdInformer)
+				enableUnplugInterfacesOfDeletedNetworks()
+				vm, vmi := DefaultVirtualMachine(true)
+				withSecondaryNetwork(vm, vmi)
+				addVirtualMachine(vm)
+				markAsReady(vmi)
+				vmiFeeder.Add(vmi)
+
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Do(func(ctx context.Context, obj interface{}) {
+					Expect(virtcontroller.NewVirtualMachineConditionManager().
+						HasCondition(obj.(*virtv1.VirtualMachine), virtv1.VirtualMachineNetworkAttachmentDefinitionMissing)).To(BeFalse())
+				}).Return(vm, nil).AnyTimes()
+
+				controller.Execute()
+			})
+		})
+
+		Context("with an interface hotplug request", func() {
+			const (
+				networkName = "blue"
+				nadName     = "blue-net"
+			)
+
+			BeforeEach(func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &virtv1.KubeVirt{
+					Spec: virtv1.KubeVirtSpec{
+						Configuration: virtv1.KubeVirtConfiguration{
+							DeveloperConfiguration: &virtv1.DeveloperConfiguration{
+								FeatureGates: []string{virtconfig.HotplugNetworkIfacesGate},
+							},
+						},
+					},
+				})
+			})
+
+			DescribeTable("should apply the request on the VMI, whether it is starting or running", func(phase virtv1.VirtualMachineInstancePhase) {
+				vm, vmi := DefaultVirtualMachine(true)
+				vmi.Status.Phase = phase
+				vm.Spec.Template.Spec.Networks = append(vm.Spec.Template.Spec.Networks, virtv1.Network{
+					Name:          networkName,
+					NetworkSource: virtv1.NetworkSource{Multus: &virtv1.MultusNetwork{NetworkName: nadName}},
+				})
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = append(vm.Spec.Template.Spec.Domain.Devices.Interfaces, virtv1.Interface{
+					Name:                   networkName,
+					InterfaceBindingMethod: virtv1.InterfaceBindingMethod{Bridge: &virtv1.InterfaceBridge{}},
+				})
+				addVirtualMachine(vm)
+				vmiFeeder.Add(vmi)
+
+				vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).
+					DoAndReturn(func(_ context.Context, _ string, _ types.PatchType, data []byte, _ *metav1.PatchOptions, _ ...string) (*virtv1.VirtualMachineInstance, error) {
+						Expect(string(data)).To(ContainSubstring(networkName))
+						return vmi, nil
+					})
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Return(vm, nil).AnyTimes()
+
+				controller.Execute()
+			},
+				Entry("when the VMI is scheduling", virtv1.Scheduling),
+				Entry("when the VMI is scheduled", virtv1.Scheduled),
+				Entry("when the VMI is running", virtv1.Running),
+			)
+
+			DescribeTable("should retain the MAC of an unplugged interface on the VM", func(retainMACOnUnplug bool) {
+				const macAddress = "02:00:00:00:00:01"
+
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &virtv1.KubeVirt{
+					Spec: virtv1.KubeVirtSpec{
+						Configuration: virtv1.KubeVirtConfiguration{
+							DeveloperConfiguration: &virtv1.DeveloperConfiguration{
+								FeatureGates: []string{virtconfig.HotplugNetworkIfacesGate},
+							},
+							NetworkConfiguration: &virtv1.NetworkConfiguration{
+								RetainMACOnUnplug: pointer.Bool(retainMACOnUnplug),
+							},
+						},
+					},
+				})
+				vm, vmi := DefaultVirtualMachine(true)
+				network := virtv1.Network{
+					Name:          networkName,
+					NetworkSource: virtv1.NetworkSource{Multus: &virtv1.MultusNetwork{NetworkName: nadName}},
+				}
+				iface := virtv1.Interface{
+					Name:                   networkName,
+					InterfaceBindingMethod: virtv1.InterfaceBindingMethod{Bridge: &virtv1.InterfaceBridge{}},
+				}
+				for _, spec := range []*virtv1.VirtualMachineInstanceSpec{&vm.Spec.Template.Spec, &vmi.Spec} {
+					spec.Networks = append(spec.Networks, network)
+					spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, iface)
+				}
+				vmi.Status.Interfaces = append(vmi.Status.Interfaces, virtv1.VirtualMachineInstanceNetworkInterface{Name: networkName, MAC: macAddress})
+				vmispec.LookupInterfaceByName(vm.Spec.Template.Spec.Domain.Devices.Interfaces, networkName).State = virtv1.InterfaceStateAbsent
+				addVirtualMachine(vm)
+				vmiFeeder.Add(vmi)
+
+				vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).Return(vmi, nil)
+				if retainMACOnUnplug {
+					vmInterface.EXPECT().Update(context.Background(), gomock.Any()).DoAndReturn(func(ctx context.Context, obj interface{}) (*virtv1.VirtualMachine, error) {
+						updatedVM := obj.(*virtv1.VirtualMachine)
+						iface := vmispec.LookupInterfaceByName(updatedVM.Spec.Template.Spec.Domain.Devices.Interfaces, networkName)
+						Expect(iface).ToNot(BeNil())
+						Expect(iface.MacAddress).To(Equal(macAddress))
+						return updatedVM, nil
+					})
+				}
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Return(vm, nil).AnyTimes()
+
+				controller.Execute()
+			},
+				Entry("when retaining the MAC on unplug is enabled", true),
+				Entry("when retaining the MAC on unplug is disabled", false),
+			)
+		})
+
+		Context("with desired interfaces declared by an external controller", func() {
+			const (
+				unpluggedNetworkName = "red"
+				nadName              = "some-net"
+			)
+
+			BeforeEach(func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &virtv1.KubeVirt{
+					Spec: virtv1.KubeVirtSpec{
+						Configuration: virtv1.KubeVirtConfiguration{
+							DeveloperConfiguration: &virtv1.DeveloperConfiguration{
+								FeatureGates: []string{virtconfig.HotplugNetworkIfacesGate},
+							},
+						},
+					},
+				})
+			})
+
+			newDesiredInterface := func(networkName string) vmispec.DesiredInterface {
+				return vmispec.DesiredInterface{
+					Interface: virtv1.Interface{
+						Name:                   networkName,
+						InterfaceBindingMethod: virtv1.InterfaceBindingMethod{Bridge: &virtv1.InterfaceBridge{}},
+					},
+					Network: virtv1.Network{
+						Name:          networkName,
+						NetworkSource: virtv1.NetworkSource{Multus: &virtv1.MultusNetwork{NetworkName: nadName}},
+					},
+				}
+			}
+
+			newRunningVMWithSecondaryInterface := func() (*virtv1.VirtualMachine, *virtv1.VirtualMachineInstance) {
+				vm, vmi := DefaultVirtualMachine(true)
+				unpluggedIface := newDesiredInterface(unpluggedNetworkName)
+				for _, spec := range []*virtv1.VirtualMachineInstanceSpec{&vm.Spec.Template.Spec, &vmi.Spec} {
+					spec.Networks = append(spec.Networks, unpluggedIface.Network)
+					spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, unpluggedIface.Interface)
+				}
+				vmi.Status.Interfaces = append(vmi.Status.Interfaces, virtv1.VirtualMachineInstanceNetworkInterface{Name: unpluggedNetworkName})
+				markAsReady(vmi)
+				return vm, vmi
+			}
+
+			It("should plug the desired interfaces and unplug the undesired ones, on the VM and the VMI", func() {
+				vm, vmi := newRunningVMWithSecondaryInterface()
+				desiredIfaces, err := json.Marshal([]vmispec.DesiredInterface{newDesiredInterface("blue"), newDesiredInterface("green")})
+				Expect(err).ToNot(HaveOccurred())
+				vm.Annotations[virtv1.DesiredInterfacesAnnotation] = string(desiredIfaces)
+				addVirtualMachine(vm)
+				vmiFeeder.Add(vmi)
+
+				vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).
+					DoAndReturn(func(_ context.Context, _ string, _ types.PatchType, data []byte, _ *metav1.PatchOptions, _ ...string) (*virtv1.VirtualMachineInstance, error) {
+						Expect(string(data)).To(And(ContainSubstring("blue"), ContainSubstring("green"), ContainSubstring(string(virtv1.InterfaceStateAbsent))))
+						return vmi, nil
+					})
+				vmInterface.EXPECT().Update(context.Background(), gomock.Any()).DoAndReturn(func(ctx context.Context, obj interface{}) (*virtv1.VirtualMachine, error) {
+					updatedVM := obj.(*virtv1.VirtualMachine)
+					statesByName := map[string]virtv1.InterfaceState{}
+					for _, iface := range updatedVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+						statesByName[iface.Name] = iface.State
+					}
+					Expect(statesByName).To(Equal(map[string]virtv1.InterfaceState{
+						unpluggedNetworkName: virtv1.InterfaceStateAbsent,
+						"blue":               "",
+						"green":              "",
+					}))
+					Expect(vmispec.LookupNetworkByName(updatedVM.Spec.Template.Spec.Networks, "blue")).ToNot(BeNil())
+					Expect(vmispec.LookupNetworkByName(updatedVM.Spec.Template.Spec.Networks, "green")).ToNot(BeNil())
+					return updatedVM, nil
+				})
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Return(vm, nil).AnyTimes()
+
+				controller.Execute()
+			})
+
+			It("should not change the VM when it has no desired interfaces annotation", func() {
+				vm, vmi := newRunningVMWithSecondaryInterface()
+				addVirtualMachine(vm)
+				vmiFeeder.Add(vmi)
+
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Return(vm, nil).AnyTimes()
+
+				controller.Execute()
+			})
+
+			It("should add a fail condition when the desired interfaces annotation is malformed", func() {
+				vm, vmi := newRunningVMWithSecondaryInterface()
+				vm.Annotations[virtv1.DesiredInterfacesAnnotation] = "not a JSON list"
+				addVirtualMachine(vm)
+				vmiFeeder.Add(vmi)
+
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Do(func(ctx context.Context, obj interface{}) {
+					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(obj.(*virtv1.VirtualMachine), virtv1.VirtualMachineFailure)
+					Expect(cond).ToNot(BeNil())
+					Expect(cond.Reason).To(Equal(HotPlugNetworkInterfaceErrorReason))
+					Expect(cond.Message).To(ContainSubstring(virtv1.DesiredInterfacesAnnotation))
+					Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+				}).Return(vm, nil)
+
+				controller.Execute()
+			})
+		})
+
 		It("should back off if a sync error occurs", func() {
 			vm, vmi := DefaultVirtualMachine(false)
 
@@ -37,6 +37,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -130,6 +131,18 @@ const (
 	// MigrationBackoffReason is set when an error has occured while migrating
 	// and virt-controller is backing off before retrying.
 	MigrationBackoffReason = "MigrationBackoff"
+	// SuccessfulCreateHotplugMigrationReason is added in an event when a migration plugging
+	// network interfaces to the vmi is created.
+	SuccessfulCreateHotplugMigrationReason = "SuccessfulCreateHotplugMigration"
+	// SuccessfulPlugInterfaceReason is added in an event when the pod network status starts reporting
+	// a vmi network interface.
+	SuccessfulPlugInterfaceReason = "SuccessfulPlugInterface"
+	// SuccessfulUnplugInterfaceReason is added in an event when the pod network status no longer reports
+	// a vmi network interface.
+	SuccessfulUnplugInterfaceReason = "SuccessfulUnplugInterface"
+	// FailedPlugInterfaceReason is added in an event when the kubelet fails to create the sandbox of
+	// a vmi pod, e.g. when the CNI ADD fails, while vmi network interfaces wait to be plugged.
+	FailedPlugInterfaceReason = "FailedPlugInterface"
 )
 
 const failedToRenderLaunchManifestErrFormat = "failed to render launch manifest: %v"
@@ -146,6 +159,7 @@ func NewVMIController(templateService services.TemplateService,
 	cdiConfigInformer cache.SharedIndexInformer,
 	clusterConfig *virtconfig.ClusterConfig,
 	topologyHinter topology.Hinter,
+	migrationInformer cache.SharedIndexInformer,
 ) (*VMIController, error) {
 
 	c := &VMIController{
@@ -165,6 +179,9 @@ func NewVMIController(templateService services.TemplateService,
 		clusterConfig:      clusterConfig,
 		topologyHinter:     topologyHinter,
 		cidsMap:            newCIDsMap(),
+		migrationInformer:  migrationInformer,
+
+		recordedCNIFailures: newRecordedCNIFailures(),
 	}
 
 	_, err := c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -265,6 +282,9 @@ type VMIController struct {
 	cdiConfigInformer  cache.SharedIndexInformer
 	clusterConfig      *virtconfig.ClusterConfig
 	cidsMap            *cidsMap
+	migrationInformer  cache.SharedIndexInformer
+
+	recordedCNIFailures *recordedCNIFailures
 }
 
 func (c *VMIController) Run(threadiness int, stopCh <-chan struct{}) {
@@ -281,6 +301,7 @@ func (c *VMIController) Run(threadiness int, stopCh <-chan struct{}) {
 		c.cdiConfigInformer.HasSynced,
 		c.cdiInformer.HasSynced,
 		c.pvcInformer.HasSynced,
+		c.migrationInformer.HasSynced,
 	)
 	// Sync the CIDs from exist VMIs
 	var vmis []*virtv1.VirtualMachineInstance
@@ -751,6 +772,9 @@ func (c *VMIController) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8
 			}
 		}
 
+		if vmi.IsRunning() && vmiPodExists {
+			c.recordInterfacesCNIResults(vmi, vmiCopy, pod)
+		}
 		return nil
 	}
 
@@ -1267,6 +1291,8 @@ func (c *VMIController) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod,
 			}
 		}
 
+		c.recordInterfacesCNIFailures(vmi, pod)
+
 		if vmiSpecIfaces, vmiSpecNets, dynamicIfacesExist := calculateDynamicInterfaces(vmi); dynamicIfacesExist {
 			if err := c.handleDynamicInterfaceRequests(vmi.Namespace, vmiSpecIfaces, vmiSpecNets, pod); err != nil {
 				return &syncErrorImpl{
@@ -1274,6 +1300,16 @@ func (c *VMIController) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod,
 					reason: FailedHotplugSyncReason,
 				}
 			}
+			if c.clusterConfig.HotplugNetworkInterfacesEagerMigrationEnabled() {
+				if err := c.migrateOnInPlaceHotplugTimeout(vmi, vmiSpecNets, pod); err != nil {
+					return &syncErrorImpl{
+						err:    fmt.Errorf("failed to migrate vmi [%s/%s] to hotplug network interfaces: %w", vmi.GetNamespace(), vmi.GetName(), err),
+						reason: FailedHotplugSyncReason,
+					}
+				}
+			}
+		} else if err := c.removePodAnnotation(pod, inPlaceHotplugPendingSinceAnnotation); err != nil {
+			return &syncErrorImpl{err, FailedPodPatchReason}
 		}
 	}
 	return nil
@@ -1556,6 +1592,7 @@ func (c *VMIController) deleteVirtualMachineInstance(obj interface{}) {
 		}
 	}
 	c.lowerVMIExpectation(vmi)
+	c.recordedCNIFailures.remove(vmi.UID)
 	c.enqueueVirtualMachine(vmi)
 }
 
@@ -2297,9 +2334,105 @@ func (c *VMIController) handleDynamicInterfaceRequests(namespace string, interfa
 	return nil
 }
 
+// migrateOnInPlaceHotplugTimeout migrates the vmi when its hotplugged networks are not plugged
+// into its pod in place within the in-place hotplug timeout, so the target pod is created and
+// plugs them without waiting for the migration to be requested.
+// The wait is recorded on the pod once, so it survives controller restarts and starts over on the target pod.
+// While the migration is in flight no other migration is created.
+func (c *VMIController) migrateOnInPlaceHotplugTimeout(vmi *virtv1.VirtualMachineInstance, networks []virtv1.Network, pod *k8sv1.Pod) error {
+	if !networksMissingFromPod(networks, pod) {
+		return c.removePodAnnotation(pod, inPlaceHotplugPendingSinceAnnotation)
+	}
+
+	pendingSince, isPending := inPlaceHotplugPendingSince(pod)
+	if !isPending {
+		pendingSince = time.Now()
+		if err := c.setInPlaceHotplugPendingSince(pod, pendingSince); err != nil {
+			return err
+		}
+	}
+
+	if remaining := c.clusterConfig.GetInPlaceHotplugTimeout() - time.Since(pendingSince); remaining > 0 {
+		key, err := controller.KeyFunc(vmi)
+		if err != nil {
+			return err
+		}
+		c.Queue.AddAfter(key, remaining)
+		return nil
+	}
+
+	_, err := c.createHotplugMigration(vmi)
+	return err
+}
+
+func (c *VMIController) setInPlaceHotplugPendingSince(pod *k8sv1.Pod, pendingSince time.Time) error {
+	patchedPod, err := c.syncPodAnnotations(pod, map[string]string{
+		inPlaceHotplugPendingSinceAnnotation: pendingSince.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	if patchedPod != nil {
+		*pod = *patchedPod
+	}
+	return nil
+}
+
+func (c *VMIController) removePodAnnotation(pod *k8sv1.Pod, key string) error {
+	if _, exists := pod.Annotations[key]; !exists {
+		return nil
+	}
+	patchBytes := controller.GeneratePatchBytes([]string{
+		fmt.Sprintf(`{ "op": "remove", "path": "/metadata/annotations/%s" }`, patch.EscapeJSONPointer(key)),
+	})
+	patchedPod, err := c.clientset.CoreV1().Pods(pod.Namespace).Patch(context.Background(), pod.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	if err != nil {
+		log.Log.Object(pod).Errorf("failed to remove pod annotation %s during sync: %v", key, err)
+		return err
+	}
+	*pod = *patchedPod
+	return nil
+}
+
+// createHotplugMigration migrates the vmi, so its hotplugged network interfaces are plugged
+// into the target pod. It reports whether a migration was created.
+func (c *VMIController) createHotplugMigration(vmi *virtv1.VirtualMachineInstance) (bool, error) {
+	if !vmi.IsMigratable() || controller.VMIActivePodsCount(vmi, c.podInformer) > 1 ||
+		(vmi.Status.MigrationState != nil && !vmi.Status.MigrationState.Completed) {
+		return false, nil
+	}
+
+	objs, err := c.migrationInformer.GetIndexer().ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		return false, err
+	}
+	for _, obj := range objs {
+		migration := obj.(*virtv1.VirtualMachineInstanceMigration)
+		if migration.Spec.VMIName == vmi.Name && !migration.IsFinal() {
+			return false, nil
+		}
+	}
+
+	migration, err := c.clientset.VirtualMachineInstanceMigration(vmi.Namespace).Create(&virtv1.VirtualMachineInstanceMigration{
+		ObjectMeta: v1.ObjectMeta{
+			GenerateName: "kubevirt-nic-hotplug-",
+		},
+		Spec: virtv1.VirtualMachineInstanceMigrationSpec{
+			VMIName: vmi.Name,
+		},
+	}, &v1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	log.Log.Object(vmi).Infof("Created migration %s to hotplug network interfaces", migration.Name)
+	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulCreateHotplugMigrationReason, "Created migration %s to hotplug network interfaces", migration.Name)
+	return true, nil
+}
+
 func (c *VMIController) updateInterfaceStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) error {
 	indexedMultusStatusIfaces := services.NonDefaultMultusNetworksIndexedByIfaceName(pod)
 	ifaceNamingScheme := namescheme.CreateNetworkNameSchemeByPodNetworkStatus(vmi.Spec.Networks, indexedMultusStatusIfaces)
+	indexedIfaces := vmispec.IndexInterfaceSpecByName(vmi.Spec.Domain.Devices.Interfaces)
 	for _, network := range vmi.Spec.Networks {
 		vmiIfaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, network.Name)
 		podIfaceName, wasFound := ifaceNamingScheme[network.Name]
@@ -2307,8 +2440,14 @@ func (c *VMIController) updateInterfaceStatus(vmi *virtv1.VirtualMachineInstance
 			return fmt.Errorf("could not find the pod interface name for network [%s]", network.Name)
 		}
 
+		// The status of an unplugged interface is removed by virt-handler, it should not be added back
+		// while the pod still reports its interface, as it would cause the status to flip on every sync.
+		isAbsent := indexedIfaces[network.Name].State == virtv1.InterfaceStateAbsent
+
 		_, exists := indexedMultusStatusIfaces[podIfaceName]
 		switch {
+		case exists && vmiIfaceStatus == nil && isAbsent:
+			continue
 		case exists && vmiIfaceStatus == nil:
 			vmi.Status.Interfaces = append(vmi.Status.Interfaces, virtv1.VirtualMachineInstanceNetworkInterface{
 				Name:       network.Name,
@@ -2324,6 +2463,119 @@ func (c *VMIController) updateInterfaceStatus(vmi *virtv1.VirtualMachineInstance
 	return nil
 }
 
+// recordInterfacesCNIResults records an event on the vmi for every network interface the pod started
+// or stopped reporting in its network status, carrying the sanitized status of the plugged interface.
+// It is expected to be called once the interfaces status of the vmi is updated.
+func (c *VMIController) recordInterfacesCNIResults(oldVMI, newVMI *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) {
+	indexedMultusStatusIfaces := services.NonDefaultMultusNetworksIndexedByIfaceName(pod)
+	ifaceNamingScheme := namescheme.CreateNetworkNameSchemeByPodNetworkStatus(newVMI.Spec.Networks, indexedMultusStatusIfaces)
+
+	for _, ifaceStatus := range newVMI.Status.Interfaces {
+		if ifaceStatus.Name == "" {
+			continue
+		}
+		oldIfaceStatus := vmispec.LookupInterfaceStatusByName(oldVMI.Status.Interfaces, ifaceStatus.Name)
+		wasReported := oldIfaceStatus != nil && vmispec.ContainsInfoSource(oldIfaceStatus.InfoSource, vmispec.InfoSourceMultusStatus)
+		isReported := vmispec.ContainsInfoSource(ifaceStatus.InfoSource, vmispec.InfoSourceMultusStatus)
+
+		switch {
+		case isReported && !wasReported:
+			podIfaceName := ifaceNamingScheme[ifaceStatus.Name]
+			c.recorder.Eventf(newVMI, k8sv1.EventTypeNormal, SuccessfulPlugInterfaceReason,
+				"Plugged network interface %s into pod interface %s, pod network status: %s",
+				ifaceStatus.Name, podIfaceName, sanitizedCNIResult(indexedMultusStatusIfaces[podIfaceName]))
+		case !isReported && wasReported:
+			c.recorder.Eventf(newVMI, k8sv1.EventTypeNormal, SuccessfulUnplugInterfaceReason,
+				"Unplugged network interface %s, it is no longer reported by the network status of pod %s",
+				ifaceStatus.Name, pod.Name)
+		}
+	}
+}
+
+// recordInterfacesCNIFailures records an event on the vmi for every sandbox creation failure of its pods
+// which wait for their sandbox, e.g. a migration target pod, while some vmi network interfaces are not
+// plugged into the active pod. Only the failures which happened after the last recorded one are recorded.
+// The events of a pod are listed only while it waits for its sandbox, which is not reported by the pod
+// status, hence the vmi is synced again after cniFailuresPollInterval meanwhile.
+func (c *VMIController) recordInterfacesCNIFailures(vmi *virtv1.VirtualMachineInstance, activePod *k8sv1.Pod) {
+	pluggedIfaces := vmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface virtv1.Interface) bool {
+		return iface.State != virtv1.InterfaceStateAbsent
+	})
+	pendingNetworkNames := networksNotPluggedIntoPod(vmispec.FilterNetworksByInterfaces(vmi.Spec.Networks, pluggedIfaces), activePod)
+	if len(pendingNetworkNames) == 0 {
+		return
+	}
+
+	pendingPods, err := c.listPendingPodsOfVMI(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to list the pending pods of the vmi")
+		return
+	}
+	if len(pendingPods) == 0 {
+		return
+	}
+
+	for _, pod := range pendingPods {
+		failureEvents, err := c.podSandboxCreationFailures(pod)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("failed to list the sandbox creation failures of pod %s", pod.Name)
+			continue
+		}
+		for _, podEvent := range failureEvents {
+			if c.recordedCNIFailures.add(vmi.UID, podEventTime(podEvent)) {
+				c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedPlugInterfaceReason,
+					"Failed to plug network interfaces %s into pod %s, pod sandbox creation failure: %s",
+					strings.Join(pendingNetworkNames, ", "), pod.Name, printableTruncatedMessage(podEvent.Message))
+			}
+		}
+	}
+
+	key, err := controller.KeyFunc(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to get the key of the vmi")
+		return
+	}
+	c.Queue.AddAfter(key, cniFailuresPollInterval)
+}
+
+// listPendingPodsOfVMI returns the pods of the vmi, looked up in the pod informer, which are pending
+// and not being deleted.
+func (c *VMIController) listPendingPodsOfVMI(vmi *virtv1.VirtualMachineInstance) ([]*k8sv1.Pod, error) {
+	objs, err := c.podInformer.GetIndexer().ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var pods []*k8sv1.Pod
+	for _, obj := range objs {
+		pod := obj.(*k8sv1.Pod)
+		if pod.Status.Phase == k8sv1.PodPending && pod.DeletionTimestamp == nil && controller.IsControlledBy(pod, vmi) {
+			pods = append(pods, pod)
+		}
+	}
+	return pods, nil
+}
+
+// podSandboxCreationFailures returns the events reporting a failure to create the sandbox of the pod,
+// in the order they happened.
+func (c *VMIController) podSandboxCreationFailures(pod *k8sv1.Pod) ([]k8sv1.Event, error) {
+	podEvents, err := c.clientset.CoreV1().Events(pod.Namespace).List(context.Background(), v1.ListOptions{
+		FieldSelector: fields.Set{"involvedObject.uid": string(pod.UID), "reason": podSandboxCreationFailedReason}.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var failureEvents []k8sv1.Event
+	for _, podEvent := range podEvents.Items {
+		if podEvent.InvolvedObject.UID == pod.UID && podEvent.Reason == podSandboxCreationFailedReason {
+			failureEvents = append(failureEvents, podEvent)
+		}
+	}
+	sort.Slice(failureEvents, func(i, j int) bool {
+		return podEventTime(failureEvents[i]).Before(podEventTime(failureEvents[j]))
+	})
+	return failureEvents, nil
+}
+
 func generateInterfaceStatusPatchRequest(oldInterfaceStatus []byte, newInterfaceStatus []byte) []string {
 	return []string{
 		fmt.Sprintf(`{ "op": "test", "path": "/status/interfaces", "value": %s }`, string(oldInterfaceStatus)),
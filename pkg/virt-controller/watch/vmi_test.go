@@ -64,6 +64,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/sriov"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 )
@@ -92,6 +93,8 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 	var kubeClient *fake.Clientset
 	var networkClient *fakenetworkclient.Clientset
 	var pvcInformer cache.SharedIndexInformer
+	var migrationInformer cache.SharedIndexInformer
+	var kvInformer cache.SharedIndexInformer
 
 	var dataVolumeSource *framework.FakeControllerSource
 	var dataVolumeInformer cache.SharedIndexInformer
@@ -232,12 +235,15 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 		go podInformer.Run(stop)
 		go pvcInformer.Run(stop)
 
+		go migrationInformer.Run(stop)
+
 		go dataVolumeInformer.Run(stop)
 		Expect(cache.WaitForCacheSync(stop,
 			vmiInformer.HasSynced,
 			vmInformer.HasSynced,
 			podInformer.HasSynced,
 			pvcInformer.HasSynced,
+			migrationInformer.HasSynced,
 			dataVolumeInformer.HasSynced)).To(BeTrue())
 	}
 
@@ -259,10 +265,12 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				MinimumClusterTSCFrequency: pointer.Int64(12345),
 			},
 		}
-		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(kubevirtFakeConfig)
+		var config *virtconfig.ClusterConfig
+		config, _, kvInformer = testutils.NewFakeClusterConfigUsingKVConfig(kubevirtFakeConfig)
 		pvcInformer, _ = testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 		cdiInformer, _ = testutils.NewFakeInformerFor(&cdiv1.CDIConfig{})
 		cdiConfigInformer, _ = testutils.NewFakeInformerFor(&cdiv1.CDIConfig{})
+		migrationInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstanceMigration{})
 		controller, _ = NewVMIController(
 			services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), virtClient, config, qemuGid, "h"),
 			vmiInformer,
@@ -276,6 +284,7 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			cdiConfigInformer,
 			config,
 			topology.NewTopologyHinter(&cache.FakeCustomStore{}, &cache.FakeCustomStore{}, config),
+			migrationInformer,
 		)
 		// Wrap our workqueue to have a way to detect when we are done processing updates
 		mockQueue = testutils.NewMockWorkQueue(controller.Queue)
@@ -808,6 +817,7 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			prependInjectPodPatch(pod)
 			controller.Execute()
 			Expect(pod.Annotations).To(HaveKeyWithValue(sriov.NetworkPCIMapAnnot, `{"`+sriovNetworkName+`":"`+selectedPCIAddress+`"}`))
+			testutils.ExpectEvent(recorder, SuccessfulPlugInterfaceReason)
 		})
 	})
 
@@ -3164,6 +3174,136 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			secondVMNetwork   = "oldnet2"
 		)
 
+		Context("eager hotplug migration", func() {
+			const nadName = "red-net"
+
+			var migrationInterface *kubecli.MockVirtualMachineInstanceMigrationInterface
+			var pod *k8sv1.Pod
+
+			expectMigrationCreation := func() {
+				migrationInterface.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(migration *virtv1.VirtualMachineInstanceMigration, _ *metav1.CreateOptions) (*virtv1.VirtualMachineInstanceMigration, error) {
+						Expect(migration.Spec.VMIName).To(Equal(vmi.Name))
+						migration.Name = "kubevirt-nic-hotplug-abcde"
+						return migration, nil
+					})
+			}
+
+			setInPlaceHotplugTimeout := func(timeout time.Duration) {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &virtv1.KubeVirt{
+					Spec: virtv1.KubeVirtSpec{
+						Configuration: virtv1.KubeVirtConfiguration{
+							NetworkConfiguration: &virtv1.NetworkConfiguration{
+								InPlaceHotplugTimeout: &metav1.Duration{Duration: timeout},
+							},
+						},
+					},
+				})
+			}
+
+			BeforeEach(func() {
+				migrationInterface = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+				virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Return(migrationInterface).AnyTimes()
+
+				vmi = appendNetworkToVMI(api.NewMinimalVMI(vmName), nadName, firstVMNetwork)
+				vmi.UID = "1234"
+				vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{{
+					Type:   virtv1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				}}
+				pod = &k8sv1.Pod{ObjectMeta: metav1.ObjectMeta{
+					Name:      "virt-launcher-testvmi",
+					Namespace: k8sv1.NamespaceDefault,
+					Annotations: map[string]string{
+						networkv1.NetworkStatusAnnot: `[{"interface":"eth0","name":"k8s-pod-network","default":true}]`,
+					},
+				}}
+				prependInjectPodPatch(pod)
+			})
+
+			It("should not create a migration when the networks are plugged into the pod", func() {
+				pod.Annotations[networkv1.NetworkStatusAnnot] = `[{"interface":"eth0","name":"k8s-pod-network","default":true},{"interface":"net1","name":"` + nadName + `"}]`
+				pod.Annotations[inPlaceHotplugPendingSinceAnnotation] = time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+				migrationInterface.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.migrateOnInPlaceHotplugTimeout(vmi, vmi.Spec.Networks, pod)).To(Succeed())
+				Expect(pod.Annotations).NotTo(HaveKey(inPlaceHotplugPendingSinceAnnotation))
+			})
+
+			It("should create a migration as soon as the networks are hotplugged without an in-place hotplug timeout", func() {
+				setInPlaceHotplugTimeout(0)
+				expectMigrationCreation()
+
+				Expect(controller.migrateOnInPlaceHotplugTimeout(vmi, vmi.Spec.Networks, pod)).To(Succeed())
+				testutils.ExpectEvent(recorder, SuccessfulCreateHotplugMigrationReason)
+			})
+
+			It("should wait for the networks to be plugged in place before creating a migration by default", func() {
+				migrationInterface.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.migrateOnInPlaceHotplugTimeout(vmi, vmi.Spec.Networks, pod)).To(Succeed())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+				Expect(pod.Annotations).To(HaveKey(inPlaceHotplugPendingSinceAnnotation))
+			})
+
+			It("should create a migration once the in-place hotplug timed out, as recorded on the pod", func() {
+				setInPlaceHotplugTimeout(time.Minute)
+				pod.Annotations[inPlaceHotplugPendingSinceAnnotation] = time.Now().Add(-2 * time.Minute).UTC().Format(time.RFC3339)
+				expectMigrationCreation()
+
+				Expect(controller.migrateOnInPlaceHotplugTimeout(vmi, vmi.Spec.Networks, pod)).To(Succeed())
+				testutils.ExpectEvent(recorder, SuccessfulCreateHotplugMigrationReason)
+			})
+
+			It("should not patch the pod once a migration is created", func() {
+				setInPlaceHotplugTimeout(time.Minute)
+				pendingSince := time.Now().Add(-2 * time.Minute).UTC().Format(time.RFC3339)
+				pod.Annotations[inPlaceHotplugPendingSinceAnnotation] = pendingSince
+				expectMigrationCreation()
+
+				Expect(controller.migrateOnInPlaceHotplugTimeout(vmi, vmi.Spec.Networks, pod)).To(Succeed())
+				testutils.ExpectEvent(recorder, SuccessfulCreateHotplugMigrationReason)
+				Expect(pod.Annotations).To(HaveKeyWithValue(inPlaceHotplugPendingSinceAnnotation, pendingSince))
+			})
+
+			It("should not create a migration when another migration of the vmi is in flight", func() {
+				Expect(migrationInformer.GetStore().Add(&virtv1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{Name: "in-flight", Namespace: vmi.Namespace},
+					Spec:       virtv1.VirtualMachineInstanceMigrationSpec{VMIName: vmi.Name},
+					Status:     virtv1.VirtualMachineInstanceMigrationStatus{Phase: virtv1.MigrationRunning},
+				})).To(Succeed())
+				migrationInterface.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.createHotplugMigration(vmi)).To(BeFalse())
+			})
+
+			It("should create a migration when only migrations of other vmis are in flight", func() {
+				Expect(migrationInformer.GetStore().Add(&virtv1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{Name: "in-flight", Namespace: vmi.Namespace},
+					Spec:       virtv1.VirtualMachineInstanceMigrationSpec{VMIName: "other-vmi"},
+					Status:     virtv1.VirtualMachineInstanceMigrationStatus{Phase: virtv1.MigrationRunning},
+				})).To(Succeed())
+				expectMigrationCreation()
+
+				Expect(controller.createHotplugMigration(vmi)).To(BeTrue())
+				testutils.ExpectEvent(recorder, SuccessfulCreateHotplugMigrationReason)
+			})
+
+			It("should not create a migration when the vmi is migrating", func() {
+				vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{}
+				migrationInterface.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.createHotplugMigration(vmi)).To(BeFalse())
+			})
+
+			It("should not create a migration when the vmi is not migratable", func() {
+				vmi.Status.Conditions = nil
+				migrationInterface.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.createHotplugMigration(vmi)).To(BeFalse())
+			})
+		})
+
 		Context("k8s API is down - i.e. you cannot update the pod status", func() {
 			BeforeEach(func() {
 				vmi = appendNetworkToVMI(
@@ -3342,7 +3482,249 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 					PodVmIfaceStatus{
 						vmIfaceStatus: simpleIfaceStatus(ifaceName),
 					}),
+				Entry("VMI with an unplugged interface on spec (not matched on status) still reported by the pod",
+					newVMIWithOneAbsentIface(api.NewMinimalVMI(vmName), networkName, ifaceName),
+					PodVmIfaceStatus{
+						podIfaceStatus: &networkv1.NetworkStatus{
+							Name:      networkName,
+							Interface: "pod7e0055a6880",
+						},
+					}),
 			)
+
+			DescribeTable("should not patch the VMI interfaces status at steady state", func(vmi *virtv1.VirtualMachineInstance, podIfaceStatus ...networkv1.NetworkStatus) {
+				pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning, podIfaceStatus...)
+				for i := 0; i < 3; i++ {
+					vmiCopy := vmi.DeepCopy()
+					Expect(controller.updateInterfaceStatus(vmiCopy, pod)).To(Succeed())
+					Expect(prepareVMIPatch(vmi, vmiCopy)).To(BeEmpty())
+					vmi = vmiCopy
+				}
+			},
+				Entry("when a plugged interface is reported by the domain and the pod",
+					withIfaceStatus(newVMIWithOneIface(api.NewMinimalVMI(vmName), networkName, ifaceName), virtv1.VirtualMachineInstanceNetworkInterface{
+						Name:       ifaceName,
+						MAC:        "02:00:00:00:00:01",
+						InfoSource: vmispec.NewInfoSource(vmispec.InfoSourceDomain, vmispec.InfoSourceMultusStatus),
+					}),
+					networkv1.NetworkStatus{Name: networkName, Interface: "pod7e0055a6880"},
+				),
+				Entry("when a plugged interface is reported by the domain, the guest agent and the pod",
+					withIfaceStatus(newVMIWithOneIface(api.NewMinimalVMI(vmName), networkName, ifaceName), virtv1.VirtualMachineInstanceNetworkInterface{
+						Name:          ifaceName,
+						MAC:           "02:00:00:00:00:01",
+						InterfaceName: "eth1",
+						InfoSource:    vmispec.NewInfoSource(vmispec.InfoSourceDomainAndGA, vmispec.InfoSourceMultusStatus),
+					}),
+					networkv1.NetworkStatus{Name: networkName, Interface: "pod7e0055a6880"},
+				),
+				Entry("when an unplugged interface is still reported by the pod",
+					newVMIWithOneAbsentIface(api.NewMinimalVMI(vmName), networkName, ifaceName),
+					networkv1.NetworkStatus{Name: networkName, Interface: "pod7e0055a6880"},
+				),
+			)
+
+			Context("CNI results", func() {
+				const (
+					podIfaceName          = "pod7e0055a6880"
+					sandboxFailureMessage = `Failed to create pod sandbox: plugin type="nonexistent" failed (add): failed to find plugin "nonexistent" in CNI path`
+				)
+
+				newPendingPod := func(vmi *virtv1.VirtualMachineInstance) *k8sv1.Pod {
+					vmi.UID = "vmi-uid"
+					pod := NewPodForVirtualMachine(vmi, k8sv1.PodPending)
+					pod.Name = "target-pod"
+					pod.UID = "target-pod-uid"
+					Expect(podInformer.GetStore().Add(pod)).To(Succeed())
+					return pod
+				}
+
+				newPodEvent := func(pod *k8sv1.Pod, name, reason string, lastTimestamp time.Time) k8sv1.Event {
+					return k8sv1.Event{
+						ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: pod.Namespace},
+						InvolvedObject: k8sv1.ObjectReference{Kind: "Pod", Namespace: pod.Namespace, Name: pod.Name, UID: pod.UID},
+						Reason:         reason,
+						Message:        sandboxFailureMessage,
+						LastTimestamp:  metav1.NewTime(lastTimestamp),
+					}
+				}
+
+				stubPodEvents := func(events ...k8sv1.Event) {
+					kubeClient.Fake.PrependReactor("list", "events", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+						return true, &k8sv1.EventList{Items: events}, nil
+					})
+				}
+
+				It("should record the sanitized network status of a plugged interface", func() {
+					vmi := newVMIWithOneIface(api.NewMinimalVMI(vmName), networkName, ifaceName)
+					pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning, networkv1.NetworkStatus{
+						Name:      networkName,
+						Interface: podIfaceName,
+						IPs:       []string{"10.10.10.2"},
+						Mac:       "02:00:00:00:00:01",
+						DNS:       networkv1.DNS{Nameservers: []string{"10.10.10.1"}},
+						DeviceInfo: &networkv1.DeviceInfo{
+							Type: "pci",
+							Pci:  &networkv1.PciDevice{PciAddress: "0000:04:02.5"},
+						},
+					})
+					vmiCopy := vmi.DeepCopy()
+					Expect(controller.updateInterfaceStatus(vmiCopy, pod)).To(Succeed())
+
+					controller.recordInterfacesCNIResults(vmi, vmiCopy, pod)
+
+					var event string
+					Expect(recorder.Events).To(Receive(&event))
+					Expect(event).To(ContainSubstring(SuccessfulPlugInterfaceReason))
+					Expect(event).To(ContainSubstring(
+						`pod network status: {"interface":"pod7e0055a6880","ips":["10.10.10.2"],"mac":"02:00:00:00:00:01"}`,
+					))
+					Expect(event).NotTo(ContainSubstring("10.10.10.1"))
+					Expect(event).NotTo(ContainSubstring("0000:04:02.5"))
+				})
+
+				It("should record the sandbox creation failures of a pending pod while an interface is pending, once", func() {
+					vmi := newVMIWithOneIface(api.NewMinimalVMI(vmName), networkName, ifaceName)
+					activePod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+					pendingPod := newPendingPod(vmi)
+					stubPodEvents(
+						newPodEvent(pendingPod, "failure", podSandboxCreationFailedReason, time.Now()),
+						newPodEvent(pendingPod, "scheduled", "Scheduled", time.Now()),
+					)
+
+					controller.recordInterfacesCNIFailures(vmi, activePod)
+					controller.recordInterfacesCNIFailures(vmi, activePod)
+
+					var event string
+					Expect(recorder.Events).To(Receive(&event))
+					Expect(event).To(ContainSubstring(k8sv1.EventTypeWarning))
+					Expect(event).To(ContainSubstring(FailedPlugInterfaceReason))
+					Expect(event).To(ContainSubstring("Failed to plug network interfaces " + ifaceName + " into pod " + pendingPod.Name))
+					Expect(event).To(ContainSubstring(`pod sandbox creation failure: Failed to create pod sandbox: plugin type="nonexistent"`))
+					Expect(recorder.Events).To(BeEmpty())
+					Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(2))
+				})
+
+				It("should record a sandbox creation failure which happened after the recorded one", func() {
+					vmi := newVMIWithOneIface(api.NewMinimalVMI(vmName), networkName, ifaceName)
+					activePod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+					pendingPod := newPendingPod(vmi)
+					failureTime := time.Now()
+					failureEvent := newPodEvent(pendingPod, "failure", podSandboxCreationFailedReason, failureTime)
+					stubPodEvents(failureEvent)
+					controller.recordInterfacesCNIFailures(vmi, activePod)
+					testutils.ExpectEvent(recorder, FailedPlugInterfaceReason)
+
+					stubPodEvents(failureEvent, newPodEvent(pendingPod, "later-failure", podSandboxCreationFailedReason, failureTime.Add(time.Minute)))
+					controller.recordInterfacesCNIFailures(vmi, activePod)
+
+					testutils.ExpectEvent(recorder, FailedPlugInterfaceReason)
+					Expect(recorder.Events).To(BeEmpty())
+				})
+
+				It("should record a sandbox creation failure again once the VMI is recreated", func() {
+					vmi := newVMIWithOneIface(api.NewMinimalVMI(vmName), networkName, ifaceName)
+					activePod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+					pendingPod := newPendingPod(vmi)
+					stubPodEvents(newPodEvent(pendingPod, "failure", podSandboxCreationFailedReason, time.Now()))
+					controller.recordInterfacesCNIFailures(vmi, activePod)
+					testutils.ExpectEvent(recorder, FailedPlugInterfaceReason)
+
+					controller.recordedCNIFailures.remove(vmi.UID)
+					controller.recordInterfacesCNIFailures(vmi, activePod)
+
+					testutils.ExpectEvent(recorder, FailedPlugInterfaceReason)
+				})
+
+				It("should not record a sandbox creation failure of a pod not controlled by the VMI", func() {
+					vmi := newVMIWithOneIface(api.NewMinimalVMI(vmName), networkName, ifaceName)
+					activePod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+					otherPod := newPendingPod(vmi)
+					otherPod.Labels[virtv1.CreatedByLabel] = "other-vmi-uid"
+					stubPodEvents(newPodEvent(otherPod, "failure", podSandboxCreationFailedReason, time.Now()))
+
+					controller.recordInterfacesCNIFailures(vmi, activePod)
+
+					Expect(recorder.Events).To(BeEmpty())
+					Expect(mockQueue.GetAddAfterEnqueueCount()).To(BeZero())
+				})
+
+				It("should not look up sandbox creation failures when no interface is pending", func() {
+					vmi := newVMIWithOneIface(api.NewMinimalVMI(vmName), networkName, ifaceName)
+					activePod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning, networkv1.NetworkStatus{
+						Name:      networkName,
+						Interface: podIfaceName,
+					})
+					pendingPod := newPendingPod(vmi)
+					stubPodEvents(newPodEvent(pendingPod, "failure", podSandboxCreationFailedReason, time.Now()))
+
+					controller.recordInterfacesCNIFailures(vmi, activePod)
+
+					Expect(recorder.Events).To(BeEmpty())
+					Expect(mockQueue.GetAddAfterEnqueueCount()).To(BeZero())
+				})
+
+				It("should record the unplug of an interface the pod no longer reports", func() {
+					vmi := withIfaceStatus(
+						newVMIWithOneAbsentIface(api.NewMinimalVMI(vmName), networkName, ifaceName),
+						*readyHotpluggedIfaceStatus(ifaceName),
+					)
+					pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+					vmiCopy := vmi.DeepCopy()
+					vmiCopy.Status.Interfaces[0].InfoSource = vmispec.InfoSourceDomain
+
+					controller.recordInterfacesCNIResults(vmi, vmiCopy, pod)
+
+					var event string
+					Expect(recorder.Events).To(Receive(&event))
+					Expect(event).To(ContainSubstring(k8sv1.EventTypeNormal))
+					Expect(event).To(ContainSubstring(SuccessfulUnplugInterfaceReason))
+					Expect(event).To(ContainSubstring("Unplugged network interface " + ifaceName))
+					Expect(recorder.Events).To(BeEmpty())
+				})
+
+				DescribeTable("should record the plug of an interface only once the VMI status is patched", func(patchErr error, expectedEvents int) {
+					vmi := newVMIWithOneIface(api.NewMinimalVMI(vmName), networkName, ifaceName)
+					vmi.Status.Phase = virtv1.Running
+					pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning, networkv1.NetworkStatus{
+						Name:      networkName,
+						Interface: podIfaceName,
+					})
+
+					vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).Return(vmi, patchErr)
+					err := controller.updateStatus(vmi, pod, nil, nil)
+					if patchErr != nil {
+						Expect(err).To(MatchError(ContainSubstring(patchErr.Error())))
+					} else {
+						Expect(err).ToNot(HaveOccurred())
+					}
+
+					Expect(recorder.Events).To(HaveLen(expectedEvents))
+					if expectedEvents > 0 {
+						testutils.ExpectEvent(recorder, SuccessfulPlugInterfaceReason)
+					}
+				},
+					Entry("when the patch succeeds", nil, 1),
+					Entry("not when the patch fails", fmt.Errorf("patch failed"), 0),
+				)
+
+				It("should not record a CNI result of an interface which is already reported by the pod", func() {
+					vmi := withIfaceStatus(
+						newVMIWithOneIface(api.NewMinimalVMI(vmName), networkName, ifaceName),
+						*readyHotpluggedIfaceStatus(ifaceName),
+					)
+					pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning, networkv1.NetworkStatus{
+						Name:      networkName,
+						Interface: podIfaceName,
+					})
+					vmiCopy := vmi.DeepCopy()
+					Expect(controller.updateInterfaceStatus(vmiCopy, pod)).To(Succeed())
+
+					controller.recordInterfacesCNIResults(vmi, vmiCopy, pod)
+
+					Expect(recorder.Events).To(BeEmpty())
+				})
+			})
 		})
 	})
 })
@@ -3634,6 +4016,17 @@ func newVMIWithOneIface(vmi *virtv1.VirtualMachineInstance, networkName string,
 	return vmi
 }
 
+func newVMIWithOneAbsentIface(vmi *virtv1.VirtualMachineInstance, networkName string, ifaceName string) *virtv1.VirtualMachineInstance {
+	vmi = newVMIWithOneIface(vmi, networkName, ifaceName)
+	vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, ifaceName).State = virtv1.InterfaceStateAbsent
+	return vmi
+}
+
+func withIfaceStatus(vmi *virtv1.VirtualMachineInstance, ifaceStatus virtv1.VirtualMachineInstanceNetworkInterface) *virtv1.VirtualMachineInstance {
+	vmi.Status.Interfaces = append(vmi.Status.Interfaces, ifaceStatus)
+	return vmi
+}
+
 func newVMIWithOneIfaceStatus(vmi *virtv1.VirtualMachineInstance, ifaceName string) *virtv1.VirtualMachineInstance {
 	vmi.Status.Interfaces = append(vmi.Status.Interfaces, *simpleIfaceStatus(ifaceName))
 	return vmi
@@ -981,6 +981,34 @@ func (d *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.Virt
 	}
 }
 
+func (d *VirtualMachineController) updateGuestInterfacesConfigCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil {
+		return
+	}
+	if domain.Spec.Metadata.KubeVirt.GuestInterfacesConfig == nil {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestInterfacesConfigured)
+		return
+	}
+
+	message := domain.Spec.Metadata.KubeVirt.GuestInterfacesConfig.Message
+	status := k8sv1.ConditionFalse
+	if domain.Spec.Metadata.KubeVirt.GuestInterfacesConfig.Succeeded {
+		status = k8sv1.ConditionTrue
+	}
+
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGuestInterfacesConfigured)
+	if condition != nil && condition.Status == status && condition.Message == message {
+		return
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestInterfacesConfigured)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGuestInterfacesConfigured,
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Message:            message,
+	})
+}
+
 func (d *VirtualMachineController) updateLiveMigrationConditions(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
 
 	// Cacluate whether the VM is migratable
@@ -1280,6 +1308,7 @@ func (d *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 
 func (d *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) error {
 	d.updateAccessCredentialConditions(vmi, domain, condManager)
+	d.updateGuestInterfacesConfigCondition(vmi, domain, condManager)
 	d.updateLiveMigrationConditions(vmi, condManager)
 	err := d.updateGuestAgentConditions(vmi, domain, condManager)
 	if err != nil {
@@ -1436,7 +1465,7 @@ func calculatePausedCondition(vmi *v1.VirtualMachineInstance, reason api.StateCh
 			Status:             k8sv1.ConditionTrue,
 			LastProbeTime:      now,
 			LastTransitionTime: now,
-			Reason:             "PausedByUser",
+			Reason:             v1.PausedByUserReason,
 			Message:            "VMI was paused by user",
 		})
 	case api.ReasonPausedIOError:
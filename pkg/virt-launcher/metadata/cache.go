@@ -26,11 +26,12 @@ import (
 )
 
 type Cache struct {
-	UID              SafeData[types.UID]
-	Migration        SafeData[api.MigrationMetadata]
-	GracePeriod      SafeData[api.GracePeriodMetadata]
-	AccessCredential SafeData[api.AccessCredentialMetadata]
-	MemoryDump       SafeData[api.MemoryDumpMetadata]
+	UID                   SafeData[types.UID]
+	Migration             SafeData[api.MigrationMetadata]
+	GracePeriod           SafeData[api.GracePeriodMetadata]
+	AccessCredential      SafeData[api.AccessCredentialMetadata]
+	MemoryDump            SafeData[api.MemoryDumpMetadata]
+	GuestInterfacesConfig SafeData[api.GuestInterfacesConfigMetadata]
 
 	notificationSignal chan struct{}
 }
@@ -44,6 +45,7 @@ func NewCache() *Cache {
 	cache.GracePeriod.dirtyChanel = cache.notificationSignal
 	cache.AccessCredential.dirtyChanel = cache.notificationSignal
 	cache.MemoryDump.dirtyChanel = cache.notificationSignal
+	cache.GuestInterfacesConfig.dirtyChanel = cache.notificationSignal
 	return cache
 }
 
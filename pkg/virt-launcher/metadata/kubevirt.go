@@ -44,5 +44,8 @@ func LoadKubevirtMetadata(metadataCache *Cache) api.KubeVirtMetadata {
 	if value, exists := metadataCache.MemoryDump.Load(); exists {
 		kubevirtMetadata.MemoryDump = &value
 	}
+	if value, exists := metadataCache.GuestInterfacesConfig.Load(); exists {
+		kubevirtMetadata.GuestInterfacesConfig = &value
+	}
 	return kubevirtMetadata
 }
@@ -1733,6 +1733,22 @@ func (in *GraphicsListen) DeepCopy() *GraphicsListen {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestInterfacesConfigMetadata) DeepCopyInto(out *GuestInterfacesConfigMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestInterfacesConfigMetadata.
+func (in *GuestInterfacesConfigMetadata) DeepCopy() *GuestInterfacesConfigMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestInterfacesConfigMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestOSInfo) DeepCopyInto(out *GuestOSInfo) {
 	*out = *in
@@ -1972,6 +1988,16 @@ func (in *InterfaceDriver) DeepCopyInto(out *InterfaceDriver) {
 		*out = new(uint)
 		**out = **in
 	}
+	if in.RxQueueSize != nil {
+		in, out := &in.RxQueueSize, &out.RxQueueSize
+		*out = new(uint)
+		**out = **in
+	}
+	if in.TxQueueSize != nil {
+		in, out := &in.TxQueueSize, &out.TxQueueSize
+		*out = new(uint)
+		**out = **in
+	}
 	return
 }
 
@@ -2066,6 +2092,11 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestInterfacesConfig != nil {
+		in, out := &in.GuestInterfacesConfig, &out.GuestInterfacesConfig
+		*out = new(GuestInterfacesConfigMetadata)
+		**out = **in
+	}
 	return
 }
 
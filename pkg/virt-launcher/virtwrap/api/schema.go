@@ -362,11 +362,12 @@ type Metadata struct {
 }
 
 type KubeVirtMetadata struct {
-	UID              types.UID                 `xml:"uid"`
-	GracePeriod      *GracePeriodMetadata      `xml:"graceperiod,omitempty"`
-	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
-	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
-	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	UID                   types.UID                      `xml:"uid"`
+	GracePeriod           *GracePeriodMetadata           `xml:"graceperiod,omitempty"`
+	Migration             *MigrationMetadata             `xml:"migration,omitempty"`
+	AccessCredential      *AccessCredentialMetadata      `xml:"accessCredential,omitempty"`
+	MemoryDump            *MemoryDumpMetadata            `xml:"memoryDump,omitempty"`
+	GuestInterfacesConfig *GuestInterfacesConfigMetadata `xml:"guestInterfacesConfig,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -374,6 +375,11 @@ type AccessCredentialMetadata struct {
 	Message   string `xml:"message,omitempty"`
 }
 
+type GuestInterfacesConfigMetadata struct {
+	Succeeded bool   `xml:"succeeded,omitempty"`
+	Message   string `xml:"message,omitempty"`
+}
+
 type MemoryDumpMetadata struct {
 	FileName       string       `xml:"fileName,omitempty"`
 	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
@@ -768,11 +774,18 @@ type ACPI struct {
 }
 
 type InterfaceDriver struct {
-	Name   string `xml:"name,attr"`
-	Queues *uint  `xml:"queues,attr,omitempty"`
-	IOMMU  string `xml:"iommu,attr,omitempty"`
+	Name        string `xml:"name,attr"`
+	Queues      *uint  `xml:"queues,attr,omitempty"`
+	IOMMU       string `xml:"iommu,attr,omitempty"`
+	RxQueueSize *uint  `xml:"rx_queue_size,attr,omitempty"`
+	TxQueueSize *uint  `xml:"tx_queue_size,attr,omitempty"`
 }
 
+const (
+	LinkStateUp   = "up"
+	LinkStateDown = "down"
+)
+
 type LinkState struct {
 	State string `xml:"state,attr"`
 }
@@ -362,19 +362,27 @@ func (l *LibvirtConnection) GetSEVInfo() (*api.SEVNodeParameters, error) {
 }
 
 func (l *LibvirtConnection) GetDeviceAliasMap(domain *libvirt.Domain) (map[string]string, error) {
-	devAliasMap := make(map[string]string)
-
 	domSpec := &api.DomainSpec{}
 	domxml, err := domain.GetXMLDesc(0)
 	if err != nil {
-		return devAliasMap, err
+		return map[string]string{}, err
 	}
 	err = xml.Unmarshal([]byte(domxml), domSpec)
 	if err != nil {
-		return devAliasMap, err
+		return map[string]string{}, err
 	}
 
+	return deviceAliasMap(domSpec), nil
+}
+
+func deviceAliasMap(domSpec *api.DomainSpec) map[string]string {
+	devAliasMap := make(map[string]string)
+
 	for _, iface := range domSpec.Devices.Interfaces {
+		// Interfaces which are being hot (un)plugged may not report a target or an alias yet
+		if iface.Target == nil || iface.Alias == nil {
+			continue
+		}
 		devAliasMap[iface.Target.Device] = iface.Alias.GetName()
 	}
 
@@ -382,7 +390,7 @@ func (l *LibvirtConnection) GetDeviceAliasMap(domain *libvirt.Domain) (map[strin
 		devAliasMap[disk.Target.Device] = disk.Alias.GetName()
 	}
 
-	return devAliasMap, nil
+	return devAliasMap
 }
 
 // Installs a watchdog which will check periodically if the libvirt connection is still alive.
@@ -508,6 +516,7 @@ type VirDomain interface {
 	AttachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
 	DetachDevice(xml string) error
 	DetachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
+	UpdateDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
 	DestroyFlags(flags libvirt.DomainDestroyFlags) error
 	ShutdownFlags(flags libvirt.DomainShutdownFlags) error
 	Reboot(flags libvirt.DomainRebootFlagValues) error
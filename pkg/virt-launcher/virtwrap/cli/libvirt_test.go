@@ -20,10 +20,13 @@
 package cli
 
 import (
+	"encoding/xml"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 var _ = Describe("Libvirt Suite", func() {
@@ -33,4 +36,34 @@ var _ = Describe("Libvirt Suite", func() {
 			Expect(err).To(MatchError("cannot connect to libvirt daemon: timed out waiting for the condition"))
 		})
 	})
+
+	Context("device alias map", func() {
+		It("should skip interfaces without a target or an alias, such as interfaces being hotplugged", func() {
+			const domainXML = `<domain>
+  <devices>
+    <interface type="ethernet">
+      <target dev="tap0"/>
+      <alias name="ua-default"/>
+    </interface>
+    <interface type="ethernet">
+      <alias name="ua-hotplugged"/>
+    </interface>
+    <interface type="ethernet">
+      <target dev="tap61d1e4e8f4b"/>
+    </interface>
+    <disk type="file">
+      <target dev="vda"/>
+      <alias name="ua-disk0"/>
+    </disk>
+  </devices>
+</domain>`
+			domSpec := &api.DomainSpec{}
+			Expect(xml.Unmarshal([]byte(domainXML), domSpec)).To(Succeed())
+
+			Expect(deviceAliasMap(domSpec)).To(Equal(map[string]string{
+				"tap0": "default",
+				"vda":  "disk0",
+			}))
+		})
+	})
 })
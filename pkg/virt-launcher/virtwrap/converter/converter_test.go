@@ -2252,6 +2252,22 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces[0].BootOrder.Order).To(Equal(uint(bootOrder)))
 			Expect(domain.Spec.Devices.Interfaces[1].BootOrder).To(BeNil())
 		})
+		It("should create the interfaces with IPv6 disabled with their link down", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			iface1 := v1.DefaultBridgeNetworkInterface()
+			iface2 := v1.DefaultBridgeNetworkInterface()
+			net1 := v1.DefaultPodNetwork()
+			net2 := v1.DefaultPodNetwork()
+			iface1.Name, net1.Name = "Name1", "Name1"
+			iface2.Name, net2.Name = "Name2", "Name2"
+			iface2.DisableIPv6 = true
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*iface1, *iface2}
+			vmi.Spec.Networks = []v1.Network{*net1, *net2}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(2))
+			Expect(domain.Spec.Devices.Interfaces[0].LinkState).To(BeNil())
+			Expect(domain.Spec.Devices.Interfaces[1].LinkState).To(Equal(&api.LinkState{State: api.LinkStateDown}))
+		})
 		It("Should create network configuration for masquerade interface", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			name1 := "Name"
@@ -2946,6 +2962,24 @@ var _ = Describe("Converter", func() {
 				"queues should not be set for models other than virtio")
 		})
 
+		It("should set the ring buffer sizes of the device, along with its queues", func() {
+			vmi.Spec.Domain.Devices.Interfaces[0].RingBuffers = &v1.InterfaceRingBuffers{
+				RX: pointer.Uint32(1024),
+				TX: pointer.Uint32(256),
+			}
+			domain := vmiToDomain(vmi, &ConverterContext{AllowEmulation: true})
+			Expect(domain.Spec.Devices.Interfaces[0].Driver.Queues).NotTo(BeNil())
+			Expect(*domain.Spec.Devices.Interfaces[0].Driver.RxQueueSize).To(Equal(uint(1024)))
+			Expect(*domain.Spec.Devices.Interfaces[0].Driver.TxQueueSize).To(Equal(uint(256)))
+		})
+
+		It("should set the ring buffer sizes of the device without multi-queue", func() {
+			vmi.Spec.Domain.Devices.NetworkInterfaceMultiQueue = nil
+			vmi.Spec.Domain.Devices.Interfaces[0].RingBuffers = &v1.InterfaceRingBuffers{RX: pointer.Uint32(512)}
+			domain := vmiToDomain(vmi, &ConverterContext{AllowEmulation: true})
+			Expect(domain.Spec.Devices.Interfaces[0].Driver).To(Equal(&api.InterfaceDriver{Name: "vhost", RxQueueSize: pointer.Uint(512)}))
+		})
+
 		It("should cap the maximum number of queues", func() {
 			vmi.Spec.Domain.CPU = &v1.CPU{
 				Cores:   512,
@@ -80,6 +80,13 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 			domainIface.Driver = &api.InterfaceDriver{Name: "vhost", Queues: &queueCount}
 		}
 
+		if iface.RingBuffers != nil && ifaceType == v1.VirtIO {
+			if domainIface.Driver == nil {
+				domainIface.Driver = &api.InterfaceDriver{Name: "vhost"}
+			}
+			setRingBuffers(domainIface.Driver, *iface.RingBuffers)
+		}
+
 		// Add a pciAddress if specified
 		if iface.PciAddress != "" {
 			addr, err := device.NewPciAddressField(iface.PciAddress)
@@ -93,6 +100,11 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 			domainIface.ACPI = &api.ACPI{Index: uint(iface.ACPIIndex)}
 		}
 
+		if iface.DisableIPv6 {
+			// The link is set up once IPv6 is disabled inside the guest, before any IPv6 address is assigned.
+			domainIface.LinkState = &api.LinkState{State: api.LinkStateDown}
+		}
+
 		if iface.Bridge != nil || iface.Masquerade != nil {
 			// TODO:(ihar) consider abstracting interface type conversion /
 			// detection into drivers
@@ -149,6 +161,17 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 	return domainInterfaces, nil
 }
 
+func setRingBuffers(driver *api.InterfaceDriver, ringBuffers v1.InterfaceRingBuffers) {
+	if ringBuffers.RX != nil {
+		rxQueueSize := uint(*ringBuffers.RX)
+		driver.RxQueueSize = &rxQueueSize
+	}
+	if ringBuffers.TX != nil {
+		txQueueSize := uint(*ringBuffers.TX)
+		driver.TxQueueSize = &txQueueSize
+	}
+}
+
 func GetInterfaceType(iface *v1.Interface) string {
 	if iface.Slirp != nil {
 		// Slirp configuration works only with e1000 or rtl8139
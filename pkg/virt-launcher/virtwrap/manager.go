@@ -168,6 +168,8 @@ type LibvirtDomainManager struct {
 	migrateInfoStats         *stats.DomainJobInfo
 
 	metadataCache *metadata.Cache
+
+	guestIfaceConfigurator *guestIfaceConfigurator
 }
 
 type pausedVMIs struct {
@@ -219,6 +221,12 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 	manager.hotplugHostDevicesInProgress = make(chan struct{}, maxConcurrentHotplugHostDevices)
 	manager.memoryDumpInProgress = make(chan struct{}, maxConcurrentMemoryDumps)
 	manager.credManager = accesscredentials.NewManager(connection, &manager.domainModifyLock, metadataCache)
+	guestIfaceConfigExec := func(domName, command string, args []string) (string, error) {
+		return agent.GuestExec(connection, domName, command, args, guestIfaceConfigExecTimeoutSeconds)
+	}
+	manager.guestIfaceConfigurator = newGuestIfaceConfigurator(guestIfaceConfigExec, metadataCache,
+		guestIfaceConfig{action: "disable IPv6", command: disableIPv6Command, done: manager.setIfaceLinkUp},
+	)
 
 	return &manager, nil
 }
@@ -1036,6 +1044,11 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		if vmi.ShouldStartPaused() {
 			l.paused.add(vmi.UID)
 		}
+	} else if cli.IsPaused(domState) && !l.paused.contains(vmi.UID) && vmiPausedByUser(vmi) {
+		// the vmi was paused by the user after this migration target was prepared,
+		// it should remain paused until it is explicitly unpaused.
+		logger.V(3).Info("adding vmi uuid to pausedVMIs list")
+		l.paused.add(vmi.UID)
 	} else if cli.IsPaused(domState) && !l.paused.contains(vmi.UID) {
 		// TODO: if state change reason indicates a system error, we could try something smarter
 		err := dom.Resume()
@@ -1130,6 +1143,7 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		if err := networkInterfaceManager.hotUnplugVirtioInterface(vmi, &api.Domain{Spec: oldSpec}); err != nil {
 			return nil, err
 		}
+		l.guestIfaceConfigurator.configure(vmi, domain.Spec.Name)
 	}
 
 	// TODO: check if VirtualMachineInstance Spec and Domain Spec are equal or if we have to sync
@@ -1715,6 +1729,7 @@ func (l *LibvirtDomainManager) DeleteVMI(vmi *v1.VirtualMachineInstance) error {
 		return err
 	}
 	log.Log.Object(vmi).Info("Domain undefined.")
+	l.guestIfaceConfigurator.stop()
 	return nil
 }
 
@@ -2104,6 +2119,16 @@ func (l *LibvirtDomainManager) InjectLaunchSecret(vmi *v1.VirtualMachineInstance
 	return nil
 }
 
+// check whether the user paused the VMI
+func vmiPausedByUser(vmi *v1.VirtualMachineInstance) bool {
+	for _, c := range vmi.Status.Conditions {
+		if c.Type == v1.VirtualMachineInstancePaused && c.Status == k8sv1.ConditionTrue && c.Reason == v1.PausedByUserReason {
+			return true
+		}
+	}
+	return false
+}
+
 // check whether VMI has a certain condition
 func vmiHasCondition(vmi *v1.VirtualMachineInstance, cond v1.VirtualMachineInstanceConditionType) bool {
 	if vmi == nil {
@@ -0,0 +1,283 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/executor"
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	guestIfaceConfigExecTimeoutSeconds = 10
+	// guestIfaceConfigResyncInterval is the interval at which failed commands are checked for a retry.
+	// The retries themselves are rate limited by an exponential backoff, per interface and configuration.
+	guestIfaceConfigResyncInterval = 10 * time.Second
+)
+
+type guestExecFunc func(domName, command string, args []string) (string, error)
+
+// guestIfaceCommandFunc returns the command, and its arguments, configuring the given interface through
+// its guest interface. An empty command is returned when the interface requests no such configuration.
+type guestIfaceCommandFunc func(iface v1.Interface, guestIfaceName string) (command string, args []string)
+
+// guestIfaceCommandDoneFunc completes the configuration of the given interface, once its command succeeded in the guest.
+type guestIfaceCommandDoneFunc func(domName string, iface v1.Interface) error
+
+// guestIfaceConfig is a configuration of the VMI interfaces which is applied inside the guest.
+type guestIfaceConfig struct {
+	// action describes the configuration in logs and reports, e.g. "disable IPv6".
+	action  string
+	command guestIfaceCommandFunc
+	// done, if set, is called after each successful execution of the command, e.g. to set the interface link up.
+	done guestIfaceCommandDoneFunc
+}
+
+type guestIfaceConfigKey struct {
+	ifaceName string
+	action    string
+}
+
+type guestIfaceConfigFailure struct {
+	commandLine []string
+	err         error
+}
+
+// guestIfaceConfigurator configures the VMI interfaces inside the guest, by executing commands using the guest agent.
+// The commands are executed asynchronously, without holding the domain modification lock, and failed commands are
+// retried with an exponential backoff. The outcome is reported in the domain metadata.
+type guestIfaceConfigurator struct {
+	guestExec     guestExecFunc
+	configs       []guestIfaceConfig
+	metadataCache *metadata.Cache
+	stopCh        chan struct{}
+	syncSignal    chan struct{}
+	startOnce     sync.Once
+	stopOnce      sync.Once
+
+	// lock protects the vmi and domain name to configure, which are set by configure and read by sync.
+	lock    sync.Mutex
+	vmi     *v1.VirtualMachineInstance
+	domName string
+
+	// The following fields are only accessed by sync.
+	executorPool *executor.RateLimitedExecutorPool
+	// applied tracks the command lines already executed in the guest, per interface and configuration.
+	applied  map[guestIfaceConfigKey][]string
+	failures map[guestIfaceConfigKey]guestIfaceConfigFailure
+	// agentConnectedTime identifies the guest agent connection the applied commands were executed through.
+	agentConnectedTime metav1.Time
+}
+
+func newGuestIfaceConfigurator(guestExec guestExecFunc, metadataCache *metadata.Cache, configs ...guestIfaceConfig) *guestIfaceConfigurator {
+	return &guestIfaceConfigurator{
+		guestExec:     guestExec,
+		configs:       configs,
+		metadataCache: metadataCache,
+		stopCh:        make(chan struct{}),
+		syncSignal:    make(chan struct{}, 1),
+		executorPool:  executor.NewRateLimitedExecutorPool(executor.NewExponentialLimitedBackoffCreator()),
+		applied:       map[guestIfaceConfigKey][]string{},
+		failures:      map[guestIfaceConfigKey]guestIfaceConfigFailure{},
+	}
+}
+
+// configure requests the configuration of the VMI interfaces inside the guest, without waiting for it.
+func (c *guestIfaceConfigurator) configure(vmi *v1.VirtualMachineInstance, domName string) {
+	c.lock.Lock()
+	c.vmi = vmi.DeepCopy()
+	c.domName = domName
+	c.lock.Unlock()
+
+	c.startOnce.Do(func() {
+		go c.run()
+	})
+	select {
+	case c.syncSignal <- struct{}{}:
+	default:
+	}
+}
+
+// stop terminates the asynchronous configuration, e.g. once the domain is deleted.
+func (c *guestIfaceConfigurator) stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+	})
+}
+
+func (c *guestIfaceConfigurator) run() {
+	resyncTicker := time.NewTicker(guestIfaceConfigResyncInterval)
+	defer resyncTicker.Stop()
+
+	for {
+		select {
+		case <-c.syncSignal:
+		case <-resyncTicker.C:
+		case <-c.stopCh:
+			return
+		}
+		c.sync()
+	}
+}
+
+// sync executes the configuration commands of the interfaces which are already reported by the guest agent.
+// Interfaces not reported yet are configured on a later sync, and failed commands are retried once their backoff passed.
+// The configuration does not persist across guest reboots, it is applied again once the guest agent reconnects.
+func (c *guestIfaceConfigurator) sync() {
+	c.lock.Lock()
+	vmi, domName := c.vmi, c.domName
+	c.lock.Unlock()
+	if vmi == nil {
+		return
+	}
+
+	agentCondition := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceAgentConnected)
+	if agentCondition == nil || agentCondition.Status != k8sv1.ConditionTrue {
+		return
+	}
+	if !agentCondition.LastProbeTime.Equal(&c.agentConnectedTime) {
+		c.executorPool = executor.NewRateLimitedExecutorPool(executor.NewExponentialLimitedBackoffCreator())
+		c.applied = map[guestIfaceConfigKey][]string{}
+		c.failures = map[guestIfaceConfigKey]guestIfaceConfigFailure{}
+		c.agentConnectedTime = agentCondition.LastProbeTime
+	}
+
+	indexedIfacesStatus := netvmispec.IndexInterfacesFromStatus(
+		vmi.Status.Interfaces,
+		func(ifaceStatus v1.VirtualMachineInstanceNetworkInterface) bool {
+			return netvmispec.ContainsInfoSource(ifaceStatus.InfoSource, netvmispec.InfoSourceGuestAgent)
+		},
+	)
+
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		for _, config := range c.configs {
+			key := guestIfaceConfigKey{ifaceName: iface.Name, action: config.action}
+			if iface.State == v1.InterfaceStateAbsent {
+				c.forget(key)
+				continue
+			}
+
+			guestIfaceName := indexedIfacesStatus[iface.Name].InterfaceName
+			if guestIfaceName == "" {
+				continue
+			}
+			c.configureIface(vmi, domName, key, config, iface, guestIfaceName)
+		}
+	}
+	c.forgetRemovedIfaces(vmi.Spec.Domain.Devices.Interfaces)
+
+	c.report()
+}
+
+func (c *guestIfaceConfigurator) configureIface(vmi *v1.VirtualMachineInstance, domName string, key guestIfaceConfigKey,
+	config guestIfaceConfig, iface v1.Interface, guestIfaceName string) {
+	command, args := config.command(iface, guestIfaceName)
+	if command == "" {
+		c.forget(key)
+		return
+	}
+	commandLine := append([]string{command}, args...)
+	if reflect.DeepEqual(c.applied[key], commandLine) {
+		return
+	}
+	if failure, exists := c.failures[key]; exists && !reflect.DeepEqual(failure.commandLine, commandLine) {
+		// The requested configuration changed, it is not subject to the backoff of the former one.
+		c.executorPool.Delete(key)
+	}
+
+	_ = c.executorPool.LoadOrStore(key).Exec(func() error {
+		if err := c.execute(domName, config, iface, command, args); err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to %s on interface %s", config.action, iface.Name)
+			c.failures[key] = guestIfaceConfigFailure{commandLine: commandLine, err: err}
+			return err
+		}
+		log.Log.Object(vmi).Infof("succeeded to %s on interface %s, guest interface %s", config.action, iface.Name, guestIfaceName)
+		c.applied[key] = commandLine
+		delete(c.failures, key)
+		c.executorPool.Delete(key)
+		return nil
+	})
+}
+
+func (c *guestIfaceConfigurator) execute(domName string, config guestIfaceConfig, iface v1.Interface, command string, args []string) error {
+	if _, err := c.guestExec(domName, command, args); err != nil {
+		return err
+	}
+	if config.done == nil {
+		return nil
+	}
+	return config.done(domName, iface)
+}
+
+func (c *guestIfaceConfigurator) forget(key guestIfaceConfigKey) {
+	delete(c.applied, key)
+	delete(c.failures, key)
+	c.executorPool.Delete(key)
+}
+
+// forgetRemovedIfaces drops the configuration state of the interfaces which are no longer part of the spec,
+// such that they are configured from scratch if an interface of the same name is plugged again.
+func (c *guestIfaceConfigurator) forgetRemovedIfaces(ifaces []v1.Interface) {
+	ifacesByName := netvmispec.IndexInterfaceSpecByName(ifaces)
+	for key := range c.applied {
+		if _, exists := ifacesByName[key.ifaceName]; !exists {
+			c.forget(key)
+		}
+	}
+	for key := range c.failures {
+		if _, exists := ifacesByName[key.ifaceName]; !exists {
+			c.forget(key)
+		}
+	}
+}
+
+// report stores the outcome of the interfaces guest configuration in the domain metadata,
+// once any configuration was executed.
+func (c *guestIfaceConfigurator) report() {
+	if _, reported := c.metadataCache.GuestInterfacesConfig.Load(); !reported && len(c.applied) == 0 && len(c.failures) == 0 {
+		return
+	}
+
+	var failureMessages []string
+	for key, failure := range c.failures {
+		failureMessages = append(failureMessages, fmt.Sprintf("failed to %s on interface %s: %v", key.action, key.ifaceName, failure.err))
+	}
+	sort.Strings(failureMessages)
+
+	c.metadataCache.GuestInterfacesConfig.WithSafeBlock(func(guestIfacesConfig *api.GuestInterfacesConfigMetadata, _ bool) {
+		guestIfacesConfig.Succeeded = len(failureMessages) == 0
+		guestIfacesConfig.Message = strings.Join(failureMessages, ", ")
+	})
+}
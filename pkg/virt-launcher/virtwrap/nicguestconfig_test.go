@@ -0,0 +1,274 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("nic guest configuration on virt-launcher", func() {
+	const (
+		domName        = "default_testvmi"
+		networkName    = "n1"
+		guestIfaceName = "eth1"
+	)
+
+	type guestExecCall struct {
+		domName string
+		command string
+		args    []string
+	}
+
+	var (
+		calls         []guestExecCall
+		guestExecErr  error
+		configurator  *guestIfaceConfigurator
+		metadataCache *metadata.Cache
+
+		agentConnectedTime metav1.Time
+	)
+
+	BeforeEach(func() {
+		calls = nil
+		guestExecErr = nil
+		agentConnectedTime = metav1.Unix(1000, 0)
+		metadataCache = metadata.NewCache()
+		configurator = newGuestIfaceConfigurator(
+			func(domName, command string, args []string) (string, error) {
+				calls = append(calls, guestExecCall{domName: domName, command: command, args: args})
+				return "", guestExecErr
+			},
+			metadataCache,
+			guestIfaceConfig{action: "disable IPv6", command: disableIPv6Command},
+		)
+	})
+
+	// configure syncs the configurator synchronously, without starting its asynchronous loop.
+	configure := func(vmi *v1.VirtualMachineInstance) {
+		configurator.vmi = vmi
+		configurator.domName = domName
+		configurator.sync()
+	}
+
+	newVMI := func(disableIPv6 bool, reportedGuestIfaceName string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:          v1.VirtualMachineInstanceAgentConnected,
+			Status:        k8sv1.ConditionTrue,
+			LastProbeTime: agentConnectedTime,
+		}}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   networkName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			DisableIPv6:            disableIPv6,
+		}}
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{
+			Name:          networkName,
+			InterfaceName: reportedGuestIfaceName,
+			InfoSource:    vmispec.NewInfoSource(vmispec.InfoSourceDomain, vmispec.InfoSourceGuestAgent),
+		}}
+		return vmi
+	}
+
+	It("executes the configuration command of the guest interface", func() {
+		configure(newVMI(true, guestIfaceName))
+
+		Expect(calls).To(Equal([]guestExecCall{
+			{domName: domName, command: "sysctl", args: disableIPv6SysctlArgs(guestIfaceName)},
+		}))
+	})
+
+	It("does not execute a command when the interface requests no configuration", func() {
+		configure(newVMI(false, guestIfaceName))
+
+		Expect(calls).To(BeEmpty())
+	})
+
+	It("waits for the guest agent to report the guest interface", func() {
+		configure(newVMI(true, ""))
+		Expect(calls).To(BeEmpty())
+
+		configure(newVMI(true, guestIfaceName))
+		Expect(calls).To(HaveLen(1))
+	})
+
+	It("does not execute again a command which was already executed", func() {
+		configure(newVMI(true, guestIfaceName))
+		configure(newVMI(true, guestIfaceName))
+		Expect(calls).To(HaveLen(1))
+
+		configure(newVMI(true, "eth2"))
+		Expect(calls).To(HaveLen(2))
+	})
+
+	It("executes the command again when the interface is plugged back", func() {
+		configure(newVMI(true, guestIfaceName))
+
+		unpluggedVMI := newVMI(true, guestIfaceName)
+		unpluggedVMI.Spec.Domain.Devices.Interfaces[0].State = v1.InterfaceStateAbsent
+		configure(unpluggedVMI)
+		Expect(calls).To(HaveLen(1))
+
+		configure(newVMI(true, guestIfaceName))
+		Expect(calls).To(HaveLen(2))
+	})
+
+	It("executes the command again when an interface of the same name is plugged after its removal", func() {
+		configure(newVMI(true, guestIfaceName))
+
+		removedVMI := newVMI(true, guestIfaceName)
+		removedVMI.Spec.Domain.Devices.Interfaces = nil
+		configure(removedVMI)
+		Expect(calls).To(HaveLen(1))
+
+		configure(newVMI(true, guestIfaceName))
+		Expect(calls).To(HaveLen(2))
+	})
+
+	It("does not retry to execute a failed command before its backoff passed", func() {
+		guestExecErr = fmt.Errorf("guest agent is not responding")
+		configure(newVMI(true, guestIfaceName))
+
+		guestExecErr = nil
+		configure(newVMI(true, guestIfaceName))
+		Expect(calls).To(HaveLen(1))
+	})
+
+	It("retries to execute a failed command once the guest agent reconnects", func() {
+		guestExecErr = fmt.Errorf("guest agent is not responding")
+		configure(newVMI(true, guestIfaceName))
+
+		guestExecErr = nil
+		agentConnectedTime = metav1.Unix(2000, 0)
+		configure(newVMI(true, guestIfaceName))
+		configure(newVMI(true, guestIfaceName))
+		Expect(calls).To(HaveLen(2))
+	})
+
+	It("executes a changed command regardless of the backoff of the failed one", func() {
+		guestExecErr = fmt.Errorf("guest agent is not responding")
+		configure(newVMI(true, guestIfaceName))
+
+		guestExecErr = nil
+		configure(newVMI(true, "eth2"))
+		Expect(calls).To(HaveLen(2))
+	})
+
+	It("does not report in the domain metadata before any command is executed", func() {
+		configure(newVMI(false, guestIfaceName))
+
+		_, reported := metadataCache.GuestInterfacesConfig.Load()
+		Expect(reported).To(BeFalse())
+	})
+
+	It("reports the succeeded configuration in the domain metadata", func() {
+		configure(newVMI(true, guestIfaceName))
+
+		guestIfacesConfig, _ := metadataCache.GuestInterfacesConfig.Load()
+		Expect(guestIfacesConfig).To(Equal(api.GuestInterfacesConfigMetadata{Succeeded: true}))
+	})
+
+	It("reports the failed configuration in the domain metadata until it succeeds", func() {
+		guestExecErr = fmt.Errorf("guest agent is not responding")
+		configure(newVMI(true, guestIfaceName))
+
+		guestIfacesConfig, _ := metadataCache.GuestInterfacesConfig.Load()
+		Expect(guestIfacesConfig).To(Equal(api.GuestInterfacesConfigMetadata{
+			Message: "failed to disable IPv6 on interface n1: guest agent is not responding",
+		}))
+
+		guestExecErr = nil
+		configure(newVMI(true, "eth2"))
+
+		guestIfacesConfig, _ = metadataCache.GuestInterfacesConfig.Load()
+		Expect(guestIfacesConfig).To(Equal(api.GuestInterfacesConfigMetadata{Succeeded: true}))
+	})
+
+	It("reports the succeeded configuration in the domain metadata once the failed interface is removed", func() {
+		guestExecErr = fmt.Errorf("guest agent is not responding")
+		configure(newVMI(true, guestIfaceName))
+
+		removedVMI := newVMI(true, guestIfaceName)
+		removedVMI.Spec.Domain.Devices.Interfaces = nil
+		configure(removedVMI)
+
+		guestIfacesConfig, _ := metadataCache.GuestInterfacesConfig.Load()
+		Expect(guestIfacesConfig).To(Equal(api.GuestInterfacesConfigMetadata{Succeeded: true}))
+	})
+
+	It("does not execute the command while the guest agent is not connected", func() {
+		vmi := newVMI(true, guestIfaceName)
+		vmi.Status.Conditions = nil
+		configure(vmi)
+
+		Expect(calls).To(BeEmpty())
+	})
+
+	It("completes the configuration once the command succeeded", func() {
+		var completedIfaces []string
+		configurator.configs[0].done = func(_ string, iface v1.Interface) error {
+			completedIfaces = append(completedIfaces, iface.Name)
+			return nil
+		}
+
+		guestExecErr = fmt.Errorf("guest agent is not responding")
+		configure(newVMI(true, guestIfaceName))
+		Expect(completedIfaces).To(BeEmpty())
+
+		guestExecErr = nil
+		agentConnectedTime = metav1.Unix(2000, 0)
+		configure(newVMI(true, guestIfaceName))
+		Expect(completedIfaces).To(Equal([]string{networkName}))
+	})
+
+	It("reports a failure to complete the configuration in the domain metadata", func() {
+		configurator.configs[0].done = func(string, v1.Interface) error {
+			return fmt.Errorf("failed to set the link up")
+		}
+		configure(newVMI(true, guestIfaceName))
+
+		guestIfacesConfig, _ := metadataCache.GuestInterfacesConfig.Load()
+		Expect(guestIfacesConfig).To(Equal(api.GuestInterfacesConfigMetadata{
+			Message: "failed to disable IPv6 on interface n1: failed to set the link up",
+		}))
+	})
+
+	It("executes the command again once the guest agent reconnects, e.g. after a guest reboot", func() {
+		configure(newVMI(true, guestIfaceName))
+		Expect(calls).To(HaveLen(1))
+
+		agentConnectedTime = metav1.Unix(2000, 0)
+		configure(newVMI(true, guestIfaceName))
+		configure(newVMI(true, guestIfaceName))
+		Expect(calls).To(HaveLen(2))
+	})
+})
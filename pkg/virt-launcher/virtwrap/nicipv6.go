@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+// disableIPv6Command returns the sysctl command disabling IPv6 on the interface, when requested.
+func disableIPv6Command(iface v1.Interface, guestIfaceName string) (string, []string) {
+	if !iface.DisableIPv6 {
+		return "", nil
+	}
+	return "sysctl", disableIPv6SysctlArgs(guestIfaceName)
+}
+
+func disableIPv6SysctlArgs(guestIfaceName string) []string {
+	return []string{"-w", fmt.Sprintf("net.ipv6.conf.%s.disable_ipv6=1", guestIfaceName)}
+}
+
+// setIfaceLinkUp sets the link of the domain interface up, once IPv6 is disabled on it inside the guest.
+func (l *LibvirtDomainManager) setIfaceLinkUp(domName string, iface v1.Interface) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+	return setDomainIfaceLinkUp(dom, iface.Name)
+}
+
+// setDomainIfaceLinkUp sets the link of the domain interface up, if it is down.
+// Interfaces with IPv6 disabled are created with their link down, such that the guest assigns them no IPv6 address,
+// not even a link local one, until IPv6 is disabled on them.
+func setDomainIfaceLinkUp(dom cli.VirDomain, ifaceName string) error {
+	domainSpec, err := getDomainSpec(dom)
+	if err != nil {
+		return err
+	}
+	domainIface := lookupDomainInterfaceByName(domainSpec.Devices.Interfaces, ifaceName)
+	if domainIface == nil {
+		return fmt.Errorf("failed to find interface %s in the domain", ifaceName)
+	}
+	if domainIface.LinkState == nil || domainIface.LinkState.State != api.LinkStateDown {
+		return nil
+	}
+
+	domainIface.LinkState = &api.LinkState{State: api.LinkStateUp}
+	ifaceXML, err := xml.Marshal(domainIface)
+	if err != nil {
+		return err
+	}
+	return dom.UpdateDeviceFlags(strings.ToLower(string(ifaceXML)), affectDeviceLiveAndConfigLibvirtFlags)
+}
@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("nic IPv6 disabling on virt-launcher", func() {
+	const guestIfaceName = "eth1"
+
+	DescribeTable("disable IPv6 command", func(disableIPv6 bool, expectedCommand string, expectedArgs []string) {
+		command, args := disableIPv6Command(v1.Interface{Name: "n1", DisableIPv6: disableIPv6}, guestIfaceName)
+		Expect(command).To(Equal(expectedCommand))
+		Expect(args).To(Equal(expectedArgs))
+	},
+		Entry("disables IPv6 on the guest interface", true, "sysctl", []string{"-w", "net.ipv6.conf.eth1.disable_ipv6=1"}),
+		Entry("does not disable IPv6 when not requested", false, "", nil),
+	)
+
+	Context("interface link", func() {
+		const networkName = "n1"
+
+		var mockDomain *cli.MockVirDomain
+
+		BeforeEach(func() {
+			mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		})
+
+		domainIface := func(linkState *api.LinkState) api.Interface {
+			return api.Interface{
+				Type:      "ethernet",
+				Alias:     api.NewUserDefinedAlias(networkName),
+				LinkState: linkState,
+			}
+		}
+
+		expectDomainInterfaces := func(ifaces ...api.Interface) {
+			domainSpec := api.DomainSpec{}
+			domainSpec.Devices.Interfaces = ifaces
+			domainXML, err := xml.Marshal(domainSpec)
+			Expect(err).ToNot(HaveOccurred())
+			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(string(domainXML), nil)
+		}
+
+		It("sets the link of the interface up", func() {
+			expectDomainInterfaces(domainIface(&api.LinkState{State: api.LinkStateDown}))
+
+			upIfaceXML, err := xml.Marshal(domainIface(&api.LinkState{State: api.LinkStateUp}))
+			Expect(err).ToNot(HaveOccurred())
+			mockDomain.EXPECT().UpdateDeviceFlags(strings.ToLower(string(upIfaceXML)), affectDeviceLiveAndConfigLibvirtFlags).Return(nil)
+
+			Expect(setDomainIfaceLinkUp(mockDomain, networkName)).To(Succeed())
+		})
+
+		DescribeTable("does not update the interface when its link is not down", func(linkState *api.LinkState) {
+			expectDomainInterfaces(domainIface(linkState))
+			mockDomain.EXPECT().UpdateDeviceFlags(gomock.Any(), gomock.Any()).Times(0)
+
+			Expect(setDomainIfaceLinkUp(mockDomain, networkName)).To(Succeed())
+		},
+			Entry("with the link up", &api.LinkState{State: api.LinkStateUp}),
+			Entry("with the default link state", nil),
+		)
+
+		It("fails when the interface is not in the domain", func() {
+			expectDomainInterfaces()
+
+			Expect(setDomainIfaceLinkUp(mockDomain, networkName)).To(MatchError(ContainSubstring("failed to find interface n1")))
+		})
+	})
+})
@@ -1205,10 +1205,23 @@ var CRDsValidation map[string]string = map[string]string{
               properties:
                 defaultNetworkInterface:
                   type: string
+                inPlaceHotplugTimeout:
+                  description: InPlaceHotplugTimeout is the time given to plug hotplugged
+                    interfaces into the pod in place, before the VMI is migrated to
+                    plug them, when the HotplugNICsEagerMigration feature gate is
+                    enabled. Defaults to 30s. A longer timeout delays the plug of
+                    interfaces that can only be plugged by a migration, a shorter
+                    one may migrate VMIs whose interfaces would have been plugged
+                    in place, 0 migrates them right away.
+                  type: string
                 permitBridgeInterfaceOnPodNetwork:
                   type: boolean
                 permitSlirpInterface:
                   type: boolean
+                retainMACOnUnplug:
+                  type: boolean
+                unplugInterfacesOfDeletedNetworks:
+                  type: boolean
               type: object
             obsoleteCPUModels:
               additionalProperties:
@@ -5829,6 +5842,15 @@ var CRDsValidation map[string]string = map[string]string{
                                       to interface's DHCP server
                                     type: string
                                 type: object
+                              disableIPv6:
+                                description: If true, IPv6 is disabled on the interface
+                                  inside the guest, using the guest agent. The link
+                                  of the interface is kept down until then, such that
+                                  no IPv6 address is ever assigned to it. SR-IOV interfaces
+                                  are not kept down, they may be assigned IPv6 addresses
+                                  before IPv6 is disabled. Requires a running guest
+                                  agent which is allowed to execute sysctl.
+                                type: boolean
                               macAddress:
                                 description: 'Interface MAC address. For example:
                                   de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -5889,6 +5911,33 @@ var CRDsValidation map[string]string = map[string]string{
                                   - port
                                   type: object
                                 type: array
+                              proxyARP:
+                                description: If true, proxy ARP is enabled on the
+                                  host facing side of the interface, in the virt-launcher
+                                  pod. Forwarding is not enabled, hence the pod answers
+                                  on behalf of the addresses it routes only when IPv4
+                                  forwarding is already enabled in it, e.g. by a masquerade
+                                  binding. Supported only by interfaces with bridge
+                                  binding.
+                                type: boolean
+                              ringBuffers:
+                                description: If specified, the ring buffer sizes are
+                                  set on the virtio-net device of the interface, in
+                                  the domain. The rx size must be a power of 2 between
+                                  256 and 1024, the tx size must be 256, the only
+                                  size supported by the tap backend.
+                                properties:
+                                  rx:
+                                    description: RX is the number of entries in the
+                                      receive ring buffer.
+                                    format: int32
+                                    type: integer
+                                  tx:
+                                    description: TX is the number of entries in the
+                                      transmit ring buffer.
+                                    format: int32
+                                    type: integer
+                                type: object
                               slirp:
                                 description: InterfaceSlirp connects to a given network
                                   using QEMU user networking mode.
@@ -10306,6 +10355,15 @@ var CRDsValidation map[string]string = map[string]string{
                               DHCP server
                             type: string
                         type: object
+                      disableIPv6:
+                        description: If true, IPv6 is disabled on the interface inside
+                          the guest, using the guest agent. The link of the interface
+                          is kept down until then, such that no IPv6 address is ever
+                          assigned to it. SR-IOV interfaces are not kept down, they
+                          may be assigned IPv6 addresses before IPv6 is disabled.
+                          Requires a running guest agent which is allowed to execute
+                          sysctl.
+                        type: boolean
                       macAddress:
                         description: 'Interface MAC address. For example: de:ad:00:00:be:af
                           or DE-AD-00-00-BE-AF.'
@@ -10363,6 +10421,31 @@ var CRDsValidation map[string]string = map[string]string{
                           - port
                           type: object
                         type: array
+                      proxyARP:
+                        description: If true, proxy ARP is enabled on the host facing
+                          side of the interface, in the virt-launcher pod. Forwarding
+                          is not enabled, hence the pod answers on behalf of the addresses
+                          it routes only when IPv4 forwarding is already enabled in
+                          it, e.g. by a masquerade binding. Supported only by interfaces
+                          with bridge binding.
+                        type: boolean
+                      ringBuffers:
+                        description: If specified, the ring buffer sizes are set on
+                          the virtio-net device of the interface, in the domain. The
+                          rx size must be a power of 2 between 256 and 1024, the tx
+                          size must be 256, the only size supported by the tap backend.
+                        properties:
+                          rx:
+                            description: RX is the number of entries in the receive
+                              ring buffer.
+                            format: int32
+                            type: integer
+                          tx:
+                            description: TX is the number of entries in the transmit
+                              ring buffer.
+                            format: int32
+                            type: integer
+                        type: object
                       slirp:
                         description: InterfaceSlirp connects to a given network using
                           QEMU user networking mode.
@@ -12971,6 +13054,15 @@ var CRDsValidation map[string]string = map[string]string{
                               DHCP server
                             type: string
                         type: object
+                      disableIPv6:
+                        description: If true, IPv6 is disabled on the interface inside
+                          the guest, using the guest agent. The link of the interface
+                          is kept down until then, such that no IPv6 address is ever
+                          assigned to it. SR-IOV interfaces are not kept down, they
+                          may be assigned IPv6 addresses before IPv6 is disabled.
+                          Requires a running guest agent which is allowed to execute
+                          sysctl.
+                        type: boolean
                       macAddress:
                         description: 'Interface MAC address. For example: de:ad:00:00:be:af
                           or DE-AD-00-00-BE-AF.'
@@ -13028,6 +13120,31 @@ var CRDsValidation map[string]string = map[string]string{
                           - port
                           type: object
                         type: array
+                      proxyARP:
+                        description: If true, proxy ARP is enabled on the host facing
+                          side of the interface, in the virt-launcher pod. Forwarding
+                          is not enabled, hence the pod answers on behalf of the addresses
+                          it routes only when IPv4 forwarding is already enabled in
+                          it, e.g. by a masquerade binding. Supported only by interfaces
+                          with bridge binding.
+                        type: boolean
+                      ringBuffers:
+                        description: If specified, the ring buffer sizes are set on
+                          the virtio-net device of the interface, in the domain. The
+                          rx size must be a power of 2 between 256 and 1024, the tx
+                          size must be 256, the only size supported by the tap backend.
+                        properties:
+                          rx:
+                            description: RX is the number of entries in the receive
+                              ring buffer.
+                            format: int32
+                            type: integer
+                          tx:
+                            description: TX is the number of entries in the transmit
+                              ring buffer.
+                            format: int32
+                            type: integer
+                        type: object
                       slirp:
                         description: InterfaceSlirp connects to a given network using
                           QEMU user networking mode.
@@ -15113,6 +15230,15 @@ var CRDsValidation map[string]string = map[string]string{
                                       to interface's DHCP server
                                     type: string
                                 type: object
+                              disableIPv6:
+                                description: If true, IPv6 is disabled on the interface
+                                  inside the guest, using the guest agent. The link
+                                  of the interface is kept down until then, such that
+                                  no IPv6 address is ever assigned to it. SR-IOV interfaces
+                                  are not kept down, they may be assigned IPv6 addresses
+                                  before IPv6 is disabled. Requires a running guest
+                                  agent which is allowed to execute sysctl.
+                                type: boolean
                               macAddress:
                                 description: 'Interface MAC address. For example:
                                   de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -15173,6 +15299,33 @@ var CRDsValidation map[string]string = map[string]string{
                                   - port
                                   type: object
                                 type: array
+                              proxyARP:
+                                description: If true, proxy ARP is enabled on the
+                                  host facing side of the interface, in the virt-launcher
+                                  pod. Forwarding is not enabled, hence the pod answers
+                                  on behalf of the addresses it routes only when IPv4
+                                  forwarding is already enabled in it, e.g. by a masquerade
+                                  binding. Supported only by interfaces with bridge
+                                  binding.
+                                type: boolean
+                              ringBuffers:
+                                description: If specified, the ring buffer sizes are
+                                  set on the virtio-net device of the interface, in
+                                  the domain. The rx size must be a power of 2 between
+                                  256 and 1024, the tx size must be 256, the only
+                                  size supported by the tap backend.
+                                properties:
+                                  rx:
+                                    description: RX is the number of entries in the
+                                      receive ring buffer.
+                                    format: int32
+                                    type: integer
+                                  tx:
+                                    description: TX is the number of entries in the
+                                      transmit ring buffer.
+                                    format: int32
+                                    type: integer
+                                type: object
                               slirp:
                                 description: InterfaceSlirp connects to a given network
                                   using QEMU user networking mode.
@@ -19415,6 +19568,17 @@ var CRDsValidation map[string]string = map[string]string{
                                               66 to interface's DHCP server
                                             type: string
                                         type: object
+                                      disableIPv6:
+                                        description: If true, IPv6 is disabled on
+                                          the interface inside the guest, using the
+                                          guest agent. The link of the interface is
+                                          kept down until then, such that no IPv6
+                                          address is ever assigned to it. SR-IOV interfaces
+                                          are not kept down, they may be assigned
+                                          IPv6 addresses before IPv6 is disabled.
+                                          Requires a running guest agent which is
+                                          allowed to execute sysctl.
+                                        type: boolean
                                       macAddress:
                                         description: 'Interface MAC address. For example:
                                           de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -19481,6 +19645,35 @@ var CRDsValidation map[string]string = map[string]string{
                                           - port
                                           type: object
                                         type: array
+                                      proxyARP:
+                                        description: If true, proxy ARP is enabled
+                                          on the host facing side of the interface,
+                                          in the virt-launcher pod. Forwarding is
+                                          not enabled, hence the pod answers on behalf
+                                          of the addresses it routes only when IPv4
+                                          forwarding is already enabled in it, e.g.
+                                          by a masquerade binding. Supported only
+                                          by interfaces with bridge binding.
+                                        type: boolean
+                                      ringBuffers:
+                                        description: If specified, the ring buffer
+                                          sizes are set on the virtio-net device of
+                                          the interface, in the domain. The rx size
+                                          must be a power of 2 between 256 and 1024,
+                                          the tx size must be 256, the only size supported
+                                          by the tap backend.
+                                        properties:
+                                          rx:
+                                            description: RX is the number of entries
+                                              in the receive ring buffer.
+                                            format: int32
+                                            type: integer
+                                          tx:
+                                            description: TX is the number of entries
+                                              in the transmit ring buffer.
+                                            format: int32
+                                            type: integer
+                                        type: object
                                       slirp:
                                         description: InterfaceSlirp connects to a
                                           given network using QEMU user networking
@@ -24496,6 +24689,18 @@ var CRDsValidation map[string]string = map[string]string{
                                                   option 66 to interface's DHCP server
                                                 type: string
                                             type: object
+                                          disableIPv6:
+                                            description: If true, IPv6 is disabled
+                                              on the interface inside the guest, using
+                                              the guest agent. The link of the interface
+                                              is kept down until then, such that no
+                                              IPv6 address is ever assigned to it.
+                                              SR-IOV interfaces are not kept down,
+                                              they may be assigned IPv6 addresses
+                                              before IPv6 is disabled. Requires a
+                                              running guest agent which is allowed
+                                              to execute sysctl.
+                                            type: boolean
                                           macAddress:
                                             description: 'Interface MAC address. For
                                               example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -24566,6 +24771,36 @@ var CRDsValidation map[string]string = map[string]string{
                                               - port
                                               type: object
                                             type: array
+                                          proxyARP:
+                                            description: If true, proxy ARP is enabled
+                                              on the host facing side of the interface,
+                                              in the virt-launcher pod. Forwarding
+                                              is not enabled, hence the pod answers
+                                              on behalf of the addresses it routes
+                                              only when IPv4 forwarding is already
+                                              enabled in it, e.g. by a masquerade
+                                              binding. Supported only by interfaces
+                                              with bridge binding.
+                                            type: boolean
+                                          ringBuffers:
+                                            description: If specified, the ring buffer
+                                              sizes are set on the virtio-net device
+                                              of the interface, in the domain. The
+                                              rx size must be a power of 2 between
+                                              256 and 1024, the tx size must be 256,
+                                              the only size supported by the tap backend.
+                                            properties:
+                                              rx:
+                                                description: RX is the number of entries
+                                                  in the receive ring buffer.
+                                                format: int32
+                                                type: integer
+                                              tx:
+                                                description: TX is the number of entries
+                                                  in the transmit ring buffer.
+                                                format: int32
+                                                type: integer
+                                            type: object
                                           slirp:
                                             description: InterfaceSlirp connects to
                                               a given network using QEMU user networking
@@ -0,0 +1,47 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ */
+
+package rbac
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+var _ = Describe("RBAC", func() {
+
+	Context("GetAllController", func() {
+
+		clusterRole := newControllerClusterRole()
+
+		DescribeTable("cluster role has rule",
+			func(apiGroup, resource string, verbs ...string) {
+				Expect(clusterRole.Rules).To(ContainElement(SatisfyAll(
+					WithTransform(func(rule rbacv1.PolicyRule) []string { return rule.APIGroups }, ContainElement(apiGroup)),
+					WithTransform(func(rule rbacv1.PolicyRule) []string { return rule.Resources }, ContainElement(resource)),
+					WithTransform(func(rule rbacv1.PolicyRule) []string { return rule.Verbs }, ContainElements(verbs)),
+				)))
+			},
+			Entry("to record events", "", "events", "update", "create", "patch"),
+			Entry("to list the events of the pods", "", "events", "list"),
+		)
+
+	})
+
+})
@@ -1944,6 +1944,11 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RingBuffers != nil {
+		in, out := &in.RingBuffers, &out.RingBuffers
+		*out = new(InterfaceRingBuffers)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2067,6 +2072,32 @@ func (in *InterfacePasst) DeepCopy() *InterfacePasst {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceRingBuffers) DeepCopyInto(out *InterfaceRingBuffers) {
+	*out = *in
+	if in.RX != nil {
+		in, out := &in.RX, &out.RX
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TX != nil {
+		in, out := &in.TX, &out.TX
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceRingBuffers.
+func (in *InterfaceRingBuffers) DeepCopy() *InterfaceRingBuffers {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceRingBuffers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceSRIOV) DeepCopyInto(out *InterfaceSRIOV) {
 	*out = *in
@@ -2985,6 +3016,21 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.UnplugInterfacesOfDeletedNetworks != nil {
+		in, out := &in.UnplugInterfacesOfDeletedNetworks, &out.UnplugInterfacesOfDeletedNetworks
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RetainMACOnUnplug != nil {
+		in, out := &in.RetainMACOnUnplug, &out.RetainMACOnUnplug
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InPlaceHotplugTimeout != nil {
+		in, out := &in.InPlaceHotplugTimeout, &out.InPlaceHotplugTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1225,6 +1225,21 @@ type Interface struct {
 	// The (only) value supported is `absent`, expressing a request to remove the interface.
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
+	// If specified, the ring buffer sizes are set on the virtio-net device of the interface, in the domain.
+	// The rx size must be a power of 2 between 256 and 1024, the tx size must be 256, the only size supported by the tap backend.
+	// +optional
+	RingBuffers *InterfaceRingBuffers `json:"ringBuffers,omitempty"`
+	// If true, IPv6 is disabled on the interface inside the guest, using the guest agent.
+	// The link of the interface is kept down until then, such that no IPv6 address is ever assigned to it.
+	// SR-IOV interfaces are not kept down, they may be assigned IPv6 addresses before IPv6 is disabled.
+	// Requires a running guest agent which is allowed to execute sysctl.
+	// +optional
+	DisableIPv6 bool `json:"disableIPv6,omitempty"`
+	// If true, proxy ARP is enabled on the host facing side of the interface, in the virt-launcher pod.
+	// Forwarding is not enabled, hence the pod answers on behalf of the addresses it routes only when IPv4 forwarding is already enabled in it, e.g. by a masquerade binding.
+	// Supported only by interfaces with bridge binding.
+	// +optional
+	ProxyARP bool `json:"proxyARP,omitempty"`
 }
 
 type InterfaceState string
@@ -1233,6 +1248,16 @@ const (
 	InterfaceStateAbsent InterfaceState = "absent"
 )
 
+// InterfaceRingBuffers defines the ring buffer sizes of the virtio-net device of an interface.
+type InterfaceRingBuffers struct {
+	// RX is the number of entries in the receive ring buffer.
+	// +optional
+	RX *uint32 `json:"rx,omitempty"`
+	// TX is the number of entries in the transmit ring buffer.
+	// +optional
+	TX *uint32 `json:"tx,omitempty"`
+}
+
 // Extra DHCP options to use in the interface.
 type DHCPOptions struct {
 	// If specified will pass option 67 to interface's DHCP server
@@ -647,6 +647,17 @@ func (Interface) SwaggerDoc() map[string]string {
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
 		"state":       "State represents the requested operational state of the interface.\nThe (only) value supported is `absent`, expressing a request to remove the interface.\n+optional",
+		"ringBuffers": "If specified, the ring buffer sizes are set on the virtio-net device of the interface, in the domain.\nThe rx size must be a power of 2 between 256 and 1024, the tx size must be 256, the only size supported by the tap backend.\n+optional",
+		"disableIPv6": "If true, IPv6 is disabled on the interface inside the guest, using the guest agent.\nThe link of the interface is kept down until then, such that no IPv6 address is ever assigned to it.\nSR-IOV interfaces are not kept down, they may be assigned IPv6 addresses before IPv6 is disabled.\nRequires a running guest agent which is allowed to execute sysctl.\n+optional",
+		"proxyARP":    "If true, proxy ARP is enabled on the host facing side of the interface, in the virt-launcher pod.\nForwarding is not enabled, hence the pod answers on behalf of the addresses it routes only when IPv4 forwarding is already enabled in it, e.g. by a masquerade binding.\nSupported only by interfaces with bridge binding.\n+optional",
+	}
+}
+
+func (InterfaceRingBuffers) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":   "InterfaceRingBuffers defines the ring buffer sizes of the virtio-net device of an interface.",
+		"rx": "RX is the number of entries in the receive ring buffer.\n+optional",
+		"tx": "TX is the number of entries in the transmit ring buffer.\n+optional",
 	}
 }
 
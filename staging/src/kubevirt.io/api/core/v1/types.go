@@ -492,6 +492,9 @@ const (
 	// Reflects whether the QEMU guest agent updated access credentials successfully
 	VirtualMachineInstanceAccessCredentialsSynchronized VirtualMachineInstanceConditionType = "AccessCredentialsSynchronized"
 
+	// Reflects whether the QEMU guest agent applied the guest configuration of the interfaces successfully
+	VirtualMachineInstanceGuestInterfacesConfigured VirtualMachineInstanceConditionType = "GuestInterfacesConfigured"
+
 	// Reflects whether the QEMU guest agent is connected through the channel
 	VirtualMachineInstanceUnsupportedAgent VirtualMachineInstanceConditionType = "AgentVersionNotSupported"
 
@@ -531,6 +534,9 @@ const (
 
 	// GuestNotRunningReason indicates on the Ready condition on the VMI if the underlying guest VM is not running
 	GuestNotRunningReason = "GuestNotRunning"
+
+	// PausedByUserReason indicates on the Paused condition on the VMI if the user paused the guest VM
+	PausedByUserReason = "PausedByUser"
 )
 
 type VirtualMachineInstanceMigrationConditionType string
@@ -950,6 +956,11 @@ const (
 	// vm has the pod networking bind with a bridge
 	AllowPodBridgeNetworkLiveMigrationAnnotation string = "kubevirt.io/allow-pod-bridge-network-live-migration"
 
+	// DesiredInterfacesAnnotation declares the secondary interfaces a VM should be connected to, as a JSON
+	// list of interface and network pairs. It is set by an external controller managing the VM interfaces,
+	// and the VM controller plugs and unplugs the VM interfaces to match it.
+	DesiredInterfacesAnnotation string = "kubevirt.io/desired-interfaces"
+
 	// VirtualMachineGenerationAnnotation is the generation of a Virtual Machine.
 	VirtualMachineGenerationAnnotation string = "kubevirt.io/vm-generation"
 
@@ -1586,6 +1597,10 @@ const (
 	// VirtualMachinePaused is added in a virtual machine when its vmi
 	// signals with its own condition that it is paused.
 	VirtualMachinePaused VirtualMachineConditionType = "Paused"
+
+	// VirtualMachineNetworkAttachmentDefinitionMissing is added in a virtual machine when
+	// a NetworkAttachmentDefinition backing one of its attached interfaces is deleted.
+	VirtualMachineNetworkAttachmentDefinitionMissing VirtualMachineConditionType = "NetworkAttachmentDefinitionMissing"
 )
 
 type HostDiskType string
@@ -2614,6 +2629,14 @@ type NetworkConfiguration struct {
 	NetworkInterface                  string `json:"defaultNetworkInterface,omitempty"`
 	PermitSlirpInterface              *bool  `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool  `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
+	UnplugInterfacesOfDeletedNetworks *bool  `json:"unplugInterfacesOfDeletedNetworks,omitempty"`
+	RetainMACOnUnplug                 *bool  `json:"retainMACOnUnplug,omitempty"`
+	// InPlaceHotplugTimeout is the time given to plug hotplugged interfaces into the pod in place,
+	// before the VMI is migrated to plug them, when the HotplugNICsEagerMigration feature gate is enabled.
+	// Defaults to 30s. A longer timeout delays the plug of interfaces that can only be plugged by a migration,
+	// a shorter one may migrate VMIs whose interfaces would have been plugged in place, 0 migrates them right away.
+	// +optional
+	InPlaceHotplugTimeout *metav1.Duration `json:"inPlaceHotplugTimeout,omitempty"`
 }
 
 // GuestAgentPing configures the guest-agent based ping probe
@@ -853,7 +853,8 @@ func (KSMConfiguration) SwaggerDoc() map[string]string {
 
 func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "NetworkConfiguration holds network options",
+		"":                      "NetworkConfiguration holds network options",
+		"inPlaceHotplugTimeout": "InPlaceHotplugTimeout is the time given to plug hotplugged interfaces into the pod in place,\nbefore the VMI is migrated to plug them, when the HotplugNICsEagerMigration feature gate is enabled.\nDefaults to 30s. A longer timeout delays the plug of interfaces that can only be plugged by a migration,\na shorter one may migrate VMIs whose interfaces would have been plugged in place, 0 migrates them right away.\n+optional",
 	}
 }
 
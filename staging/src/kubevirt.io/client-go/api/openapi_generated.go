@@ -389,6 +389,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.InterfaceMacvtap":                                                   schema_kubevirtio_api_core_v1_InterfaceMacvtap(ref),
 		"kubevirt.io/api/core/v1.InterfaceMasquerade":                                                schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref),
 		"kubevirt.io/api/core/v1.InterfacePasst":                                                     schema_kubevirtio_api_core_v1_InterfacePasst(ref),
+		"kubevirt.io/api/core/v1.InterfaceRingBuffers":                                               schema_kubevirtio_api_core_v1_InterfaceRingBuffers(ref),
 		"kubevirt.io/api/core/v1.InterfaceSRIOV":                                                     schema_kubevirtio_api_core_v1_InterfaceSRIOV(ref),
 		"kubevirt.io/api/core/v1.InterfaceSlirp":                                                     schema_kubevirtio_api_core_v1_InterfaceSlirp(ref),
 		"kubevirt.io/api/core/v1.KSMConfiguration":                                                   schema_kubevirtio_api_core_v1_KSMConfiguration(ref),
@@ -18433,12 +18434,32 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"ringBuffers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the ring buffer sizes are set on the virtio-net device of the interface, in the domain. The rx size must be a power of 2 between 256 and 1024, the tx size must be 256, the only size supported by the tap backend.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceRingBuffers"),
+						},
+					},
+					"disableIPv6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true, IPv6 is disabled on the interface inside the guest, using the guest agent. The link of the interface is kept down until then, such that no IPv6 address is ever assigned to it. SR-IOV interfaces are not kept down, they may be assigned IPv6 addresses before IPv6 is disabled. Requires a running guest agent which is allowed to execute sysctl.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"proxyARP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true, proxy ARP is enabled on the host facing side of the interface, in the virt-launcher pod. Forwarding is not enabled, hence the pod answers on behalf of the addresses it routes only when IPv4 forwarding is already enabled in it, e.g. by a masquerade binding. Supported only by interfaces with bridge binding.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMacvtap", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfacePasst", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.InterfaceSlirp", "kubevirt.io/api/core/v1.Port"},
+			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMacvtap", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfacePasst", "kubevirt.io/api/core/v1.InterfaceRingBuffers", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.InterfaceSlirp", "kubevirt.io/api/core/v1.Port"},
 	}
 }
 
@@ -18531,6 +18552,33 @@ func schema_kubevirtio_api_core_v1_InterfacePasst(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_InterfaceRingBuffers(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceRingBuffers defines the ring buffer sizes of the virtio-net device of an interface.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rx": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RX is the number of entries in the receive ring buffer.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"tx": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TX is the number of entries in the transmit ring buffer.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_InterfaceSRIOV(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20073,9 +20121,29 @@ func schema_kubevirtio_api_core_v1_NetworkConfiguration(ref common.ReferenceCall
 							Format: "",
 						},
 					},
+					"unplugInterfacesOfDeletedNetworks": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"retainMACOnUnplug": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"inPlaceHotplugTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InPlaceHotplugTimeout is the time given to plug hotplugged interfaces into the pod in place, before the VMI is migrated to plug them, when the HotplugNICsEagerMigration feature gate is enabled. Defaults to 30s. A longer timeout delays the plug of interfaces that can only be plugged by a migration, a shorter one may migrate VMIs whose interfaces would have been plugged in place, 0 migrates them right away.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
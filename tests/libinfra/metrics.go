@@ -119,3 +119,13 @@ func GetMetricKeyForVmiDisk(keys []string, vmiName string, diskName string) stri
 	}
 	return ""
 }
+
+func GetMetricKeyForVmiInterface(keys []string, vmiName string, ifaceName string) string {
+	for _, key := range keys {
+		if strings.Contains(key, "name=\""+vmiName+"\"") &&
+			strings.Contains(key, "interface=\""+ifaceName+"\"") {
+			return key
+		}
+	}
+	return ""
+}
@@ -106,6 +106,13 @@ func WithGateway6(gateway6 string) NetworkDataInterfaceOption {
 	}
 }
 
+func WithRoutes(routes ...CloudInitRoute) NetworkDataInterfaceOption {
+	return func(networkDataInterface *CloudInitInterface) error {
+		networkDataInterface.Routes = append(networkDataInterface.Routes, routes...)
+		return nil
+	}
+}
+
 func WithNameserverFromCluster() NetworkDataInterfaceOption {
 	return func(networkDataInterface *CloudInitInterface) error {
 		dnsServerIP, err := ClusterDNSServiceIP()
@@ -0,0 +1,75 @@
+/*
+ * This file is part of the kubevirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package libnet
+
+import (
+	"os"
+	"time"
+)
+
+// Default timeouts used while waiting for network interface hotplug and unplug flows to converge.
+const (
+	// DefaultHotplugTimeout bounds the wait for a hotplug/unplug request to be reflected on the VMI spec or status.
+	DefaultHotplugTimeout = 30 * time.Second
+	// DefaultVMIRestartTimeout bounds the wait for a restarted VM to report a new running VMI.
+	DefaultVMIRestartTimeout = 90 * time.Second
+	// DefaultVMICreationTimeout bounds the wait for a started VM to create its VMI.
+	DefaultVMICreationTimeout = 120 * time.Second
+	// DefaultGuestAgentConnectionTimeout bounds the wait for a booting guest to connect its guest agent.
+	DefaultGuestAgentConnectionTimeout = 12 * time.Minute
+)
+
+// Environment variables overriding the default timeouts, expressed as a Go duration (e.g. "2m").
+const (
+	HotplugTimeoutEnv              = "KUBEVIRT_E2E_HOTPLUG_TIMEOUT"
+	VMIRestartTimeoutEnv           = "KUBEVIRT_E2E_VMI_RESTART_TIMEOUT"
+	VMICreationTimeoutEnv          = "KUBEVIRT_E2E_VMI_CREATION_TIMEOUT"
+	GuestAgentConnectionTimeoutEnv = "KUBEVIRT_E2E_GUEST_AGENT_CONNECTION_TIMEOUT"
+)
+
+// HotplugTimeout returns the time to wait for an interface hotplug/unplug to be reflected on the VMI.
+func HotplugTimeout() time.Duration {
+	return timeoutFromEnv(HotplugTimeoutEnv, DefaultHotplugTimeout)
+}
+
+// VMIRestartTimeout returns the time to wait for a restarted VM to report a new running VMI.
+func VMIRestartTimeout() time.Duration {
+	return timeoutFromEnv(VMIRestartTimeoutEnv, DefaultVMIRestartTimeout)
+}
+
+// VMICreationTimeout returns the time to wait for a started VM to create its VMI.
+func VMICreationTimeout() time.Duration {
+	return timeoutFromEnv(VMICreationTimeoutEnv, DefaultVMICreationTimeout)
+}
+
+// GuestAgentConnectionTimeout returns the time to wait for a booting guest to connect its guest agent.
+func GuestAgentConnectionTimeout() time.Duration {
+	return timeoutFromEnv(GuestAgentConnectionTimeoutEnv, DefaultGuestAgentConnectionTimeout)
+}
+
+// timeoutFromEnv returns the duration set in the given environment variable,
+// falling back to the default when it is unset, malformed or not positive.
+func timeoutFromEnv(envName string, defaultTimeout time.Duration) time.Duration {
+	timeout, err := time.ParseDuration(os.Getenv(envName))
+	if err != nil || timeout <= 0 {
+		return defaultTimeout
+	}
+	return timeout
+}
@@ -0,0 +1,63 @@
+/*
+ * This file is part of the kubevirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package libnet_test
+
+import (
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/tests/libnet"
+)
+
+var _ = Describe("hotplug timeouts", func() {
+	DescribeTable("use the default when the environment variable is", func(envName, envValue string, timeout func() time.Duration, expected time.Duration) {
+		setEnv(envName, envValue)
+		Expect(timeout()).To(Equal(expected))
+	},
+		Entry("unset", libnet.HotplugTimeoutEnv, "", libnet.HotplugTimeout, libnet.DefaultHotplugTimeout),
+		Entry("malformed", libnet.VMIRestartTimeoutEnv, "ninety", libnet.VMIRestartTimeout, libnet.DefaultVMIRestartTimeout),
+		Entry("not positive", libnet.VMICreationTimeoutEnv, "-1m", libnet.VMICreationTimeout, libnet.DefaultVMICreationTimeout),
+	)
+
+	DescribeTable("are overridden by the environment variable", func(envName string, timeout func() time.Duration) {
+		setEnv(envName, "7m30s")
+		Expect(timeout()).To(Equal(7*time.Minute + 30*time.Second))
+	},
+		Entry("hotplug", libnet.HotplugTimeoutEnv, libnet.HotplugTimeout),
+		Entry("VMI restart", libnet.VMIRestartTimeoutEnv, libnet.VMIRestartTimeout),
+		Entry("VMI creation", libnet.VMICreationTimeoutEnv, libnet.VMICreationTimeout),
+		Entry("guest agent connection", libnet.GuestAgentConnectionTimeoutEnv, libnet.GuestAgentConnectionTimeout),
+	)
+})
+
+func setEnv(name, value string) {
+	origValue, wasSet := os.LookupEnv(name)
+	Expect(os.Setenv(name, value)).To(Succeed())
+	DeferCleanup(func() {
+		if wasSet {
+			Expect(os.Setenv(name, origValue)).To(Succeed())
+		} else {
+			Expect(os.Unsetenv(name)).To(Succeed())
+		}
+	})
+}
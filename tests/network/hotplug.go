@@ -21,30 +21,54 @@ package network
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	expect "github.com/google/goexpect"
+	k8snetworkplumbingwgv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
 
+	virtnetlink "kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	k8sv1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/rand"
+	k8sWatch "k8s.io/apimachinery/pkg/watch"
 
 	v1 "kubevirt.io/api/core/v1"
 
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch"
 
 	"kubevirt.io/kubevirt/tests"
 	"kubevirt.io/kubevirt/tests/console"
 	"kubevirt.io/kubevirt/tests/decorators"
+	"kubevirt.io/kubevirt/tests/events"
+	"kubevirt.io/kubevirt/tests/exec"
 	"kubevirt.io/kubevirt/tests/framework/checks"
 	"kubevirt.io/kubevirt/tests/framework/kubevirt"
+	"kubevirt.io/kubevirt/tests/framework/matcher"
+	"kubevirt.io/kubevirt/tests/libinfra"
 	"kubevirt.io/kubevirt/tests/libnet"
+	"kubevirt.io/kubevirt/tests/libnode"
 	"kubevirt.io/kubevirt/tests/libvmi"
 	"kubevirt.io/kubevirt/tests/libwait"
 	"kubevirt.io/kubevirt/tests/testsuite"
@@ -85,7 +109,7 @@ var _ = SIGDescribe("nic-hotplug", func() {
 				var err error
 				hotPluggedVMI, err = kubevirt.Client().VirtualMachineInstance(testsuite.GetTestNamespace(nil)).Get(context.Background(), hotPluggedVM.GetName(), &metav1.GetOptions{})
 				return err
-			}, 120*time.Second, 1*time.Second).ShouldNot(HaveOccurred())
+			}, libnet.VMICreationTimeout(), 1*time.Second).ShouldNot(HaveOccurred())
 			libwait.WaitUntilVMIReady(hotPluggedVMI, console.LoginToAlpine)
 
 			By("Creating a NAD")
@@ -116,7 +140,46 @@ var _ = SIGDescribe("nic-hotplug", func() {
 
 				g.Expect(vmiIfaceStatus.MAC).To(Equal(vmIfaceSpec.MacAddress),
 					"hot-plugged iface in VMI status should have a MAC address as specified in VM template spec")
-			}, time.Second*30, time.Second*3).Should(Succeed())
+			}, libnet.HotplugTimeout(), 3*time.Second).Should(Succeed())
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+
+		It("reports the in-place hotplugged interface MAC as found in the live domain", decorators.InPlaceHotplugNICs, func() {
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, inPlace)
+
+			Eventually(func() error {
+				return interfaceStatusMACMatchesDomain(hotPluggedVMI, ifaceName)
+			}, libnet.HotplugTimeout(), time.Second).Should(Succeed())
+		})
+
+		DescribeTable("does not update the VMI once the hotplug is settled", func(plugMethod hotplugMethod) {
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+			Expect(libnet.InterfaceExists(hotPluggedVMI, vmIfaceName)).To(Succeed())
+
+			assertNoVMIUpdatesAtSteadyState(hotPluggedVMI)
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+
+		DescribeTable("records the plug of the hotplugged interface", func(plugMethod hotplugMethod) {
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+
+			events.ExpectEvent(hotPluggedVMI, k8sv1.EventTypeNormal, watch.SuccessfulPlugInterfaceReason)
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+
+		DescribeTable("advances the VM observed generation once the hotplug is processed", func(plugMethod hotplugMethod) {
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+			waitForVMObservedGeneration(hotPluggedVM)
 		},
 			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
 			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
@@ -140,7 +203,7 @@ var _ = SIGDescribe("nic-hotplug", func() {
 					return v1.VmPhaseUnset
 				}
 				return newVMI.Status.Phase
-			}, 90*time.Second, 1*time.Second).Should(Equal(v1.Running))
+			}, libnet.VMIRestartTimeout(), 1*time.Second).Should(Equal(v1.Running))
 			var err error
 			hotPluggedVMI, err = kubevirt.Client().VirtualMachineInstance(hotPluggedVM.GetNamespace()).Get(context.Background(), hotPluggedVM.GetName(), &metav1.GetOptions{})
 			Expect(err).NotTo(HaveOccurred())
@@ -177,33 +240,38 @@ var _ = SIGDescribe("nic-hotplug", func() {
 			Expect(configInterface(hotPluggedVMI, vmIfaceName, ip1+subnetMask)).To(Succeed())
 
 			By("creating another VM connected to the same secondary network")
-			net := v1.Network{
-				Name: ifaceName,
-				NetworkSource: v1.NetworkSource{
-					Multus: &v1.MultusNetwork{
-						NetworkName: nadName,
-					},
-				},
-			}
+			runVMIConnectedToSecondaryNetwork(hotPluggedVMI.Status.NodeName, ip2+subnetMask)
 
-			iface := v1.Interface{
-				Name: ifaceName,
-				InterfaceBindingMethod: v1.InterfaceBindingMethod{
-					Bridge: &v1.InterfaceBridge{},
-				},
-			}
+			By("Ping from the VM with hotplugged interface to the other VM")
+			Expect(libnet.PingFromVMConsole(hotPluggedVMI, ip2)).To(Succeed())
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
 
-			anotherVmi := libvmi.NewFedora(
-				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
-				libvmi.WithNetwork(v1.DefaultPodNetwork()),
-				libvmi.WithInterface(iface),
-				libvmi.WithNetwork(&net),
-				libvmi.WithCloudInitNoCloudNetworkData(cloudInitNetworkDataWithStaticIPsByDevice("eth1", ip2+subnetMask)))
-			anotherVmi = tests.CreateVmiOnNode(anotherVmi, hotPluggedVMI.Status.NodeName)
-			libwait.WaitUntilVMIReady(anotherVmi, console.LoginToFedora)
+		DescribeTable("reports the hotplugged interface traffic in the VM network metrics", func(plugMethod hotplugMethod) {
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+
+			const subnetMask = "/24"
+			const ip1 = "10.1.1.1"
+			const ip2 = "10.1.1.2"
+
+			By("Configuring static IP address on the hotplugged interface inside the guest")
+			Expect(configInterface(hotPluggedVMI, vmIfaceName, ip1+subnetMask)).To(Succeed())
+
+			By("creating another VM connected to the same secondary network")
+			runVMIConnectedToSecondaryNetwork(hotPluggedVMI.Status.NodeName, ip2+subnetMask)
 
 			By("Ping from the VM with hotplugged interface to the other VM")
 			Expect(libnet.PingFromVMConsole(hotPluggedVMI, ip2)).To(Succeed())
+
+			By("Checking the hotplugged interface traffic is reported by the VM network metrics")
+			for _, metricName := range []string{"kubevirt_vmi_network_transmit_packets_total", "kubevirt_vmi_network_receive_packets_total"} {
+				Eventually(func() (float64, error) {
+					return vmiInterfaceMetricValue(hotPluggedVMI, metricName, ifaceName)
+				}, libnet.HotplugTimeout(), 2*time.Second).Should(BeNumerically(">", 0), "%s should count the hotplugged interface traffic", metricName)
+			}
 		},
 			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
 			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
@@ -219,52 +287,839 @@ var _ = SIGDescribe("nic-hotplug", func() {
 			const secondHotpluggedIfaceName = "iface2"
 			Expect(addInterface(hotPluggedVM, secondHotpluggedIfaceName, nadName)).To(Succeed())
 
-			By("wait for the second network to appear in the VMI spec")
-			EventuallyWithOffset(1, func() []v1.Network {
-				var err error
-				hotPluggedVMI, err = kubevirt.Client().VirtualMachineInstance(hotPluggedVMI.GetNamespace()).Get(context.Background(), hotPluggedVMI.GetName(), &metav1.GetOptions{})
-				Expect(err).NotTo(HaveOccurred())
-				return hotPluggedVMI.Spec.Networks
-			}, 30*time.Second).Should(
-				ConsistOf(
-					*v1.DefaultPodNetwork(),
-					v1.Network{
-						Name: secondHotpluggedIfaceName,
-						NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{
-							NetworkName: nadName,
-						}},
-					},
-					v1.Network{
-						Name: ifaceName,
-						NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{
-							NetworkName: nadName,
-						}},
-					},
-				))
-			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
-			Expect(libnet.InterfaceExists(hotPluggedVMI, "eth2")).To(Succeed())
+			By("wait for the second network to appear in the VMI spec")
+			EventuallyWithOffset(1, func() []v1.Network {
+				var err error
+				hotPluggedVMI, err = kubevirt.Client().VirtualMachineInstance(hotPluggedVMI.GetNamespace()).Get(context.Background(), hotPluggedVMI.GetName(), &metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				return hotPluggedVMI.Spec.Networks
+			}, libnet.HotplugTimeout()).Should(
+				ConsistOf(
+					*v1.DefaultPodNetwork(),
+					v1.Network{
+						Name: secondHotpluggedIfaceName,
+						NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{
+							NetworkName: nadName,
+						}},
+					},
+					v1.Network{
+						Name: ifaceName,
+						NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{
+							NetworkName: nadName,
+						}},
+					},
+				))
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+			Expect(libnet.InterfaceExists(hotPluggedVMI, "eth2")).To(Succeed())
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+	})
+
+	Context("a VM which has just reached the running phase", func() {
+		var hotPluggedVM *v1.VirtualMachine
+		var hotPluggedVMI *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			By("Creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+
+			By("Creating a VM")
+			hotPluggedVM, hotPluggedVMI = createVMAndWaitForRunningPhase(newVMWithOneInterface())
+
+			By("Hotplugging an interface to the VM as soon as it is running")
+			Expect(addInterface(hotPluggedVM, ifaceName, nadName)).To(Succeed())
+		})
+
+		DescribeTable("can be hotplugged a network interface", func(plugMethod hotplugMethod) {
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+			hotPluggedVMI = libwait.WaitUntilVMIReady(hotPluggedVMI, console.LoginToAlpine)
+			Expect(libnet.InterfaceExists(hotPluggedVMI, vmIfaceName)).To(Succeed())
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+	})
+
+	Context("a running VM with a custom masquerade CIDR", func() {
+		const customMasqueradeCIDR = "10.10.20.0/24"
+
+		var hotPluggedVM *v1.VirtualMachine
+		var hotPluggedVMI *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			libnet.SkipWhenClusterNotSupportIpv4()
+
+			By("Creating a VM with a custom masquerade CIDR")
+			vm := newVMWithOneInterface()
+			vm.Spec.Template.Spec.Networks[0].Pod.VMNetworkCIDR = customMasqueradeCIDR
+			hotPluggedVM, hotPluggedVMI = createRunningVM(vm, console.LoginToAlpine)
+
+			By("Creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+
+			By("Hotplugging an interface to the VM")
+			Expect(addInterface(hotPluggedVM, ifaceName, nadName)).To(Succeed())
+		})
+
+		DescribeTable("has connectivity over the primary and secondary networks", func(plugMethod hotplugMethod) {
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+
+			const subnetMask = "/24"
+			const ip1 = "10.1.1.1"
+			const ip2 = "10.1.1.2"
+
+			By("Configuring static IP address on the hotplugged interface inside the guest")
+			Expect(configInterface(hotPluggedVMI, vmIfaceName, ip1+subnetMask)).To(Succeed())
+
+			By("creating another VM connected to the same secondary network")
+			anotherVmi := runVMIConnectedToSecondaryNetwork(hotPluggedVMI.Status.NodeName, ip2+subnetMask)
+
+			assertPrimaryAndSecondaryConnectivity(hotPluggedVMI, customMasqueradeCIDR, anotherVmi, ip2)
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+	})
+
+	Context("a running VM hotplugging an interface with ring buffer sizes", func() {
+		// The virtio-net queues default to 256 descriptors, the rx queue is enlarged to its maximum.
+		// The tx queue of the tap backend supports the default size only.
+		const (
+			rxRingSize = 1024
+			txRingSize = 256
+		)
+
+		var hotPluggedVM *v1.VirtualMachine
+		var hotPluggedVMI *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			By("Creating a VM")
+			vm := tests.NewRandomVirtualMachine(libvmi.NewFedora(libvmi.WithMasqueradeNetworking()...), true)
+			hotPluggedVM, hotPluggedVMI = createRunningVM(vm, console.LoginToFedora)
+
+			By("Creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+
+			By("Hotplugging an interface with ring buffer sizes to the VM")
+			network, iface := newNetworkInterface(ifaceName, nadName)
+			iface.RingBuffers = &v1.InterfaceRingBuffers{
+				RX: pointer.Uint32(rxRingSize),
+				TX: pointer.Uint32(txRingSize),
+			}
+			Expect(patchVMWithNewInterface(hotPluggedVM, network, iface)).To(Succeed())
+		})
+
+		DescribeTable("sets the ring buffer sizes of the virtio-net device, as seen inside the guest", func(plugMethod hotplugMethod) {
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+
+			Eventually(func() error {
+				return checkGuestIfaceRingBuffers(hotPluggedVMI, vmIfaceName, rxRingSize, txRingSize)
+			}, libnet.HotplugTimeout(), 3*time.Second).Should(Succeed())
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+	})
+
+	Context("a running VM hotplugging an interface with IPv6 disabled", func() {
+		var hotPluggedVM *v1.VirtualMachine
+		var hotPluggedVMI *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			By("Creating a VM")
+			vm := tests.NewRandomVirtualMachine(libvmi.NewFedora(libvmi.WithMasqueradeNetworking()...), true)
+			hotPluggedVM, hotPluggedVMI = createRunningVM(vm, console.LoginToFedora)
+			Eventually(matcher.ThisVMI(hotPluggedVMI), libnet.GuestAgentConnectionTimeout(), 2*time.Second).Should(matcher.HaveConditionTrue(v1.VirtualMachineInstanceAgentConnected))
+
+			By("Creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+
+			By("Recording the IPv6 addresses assigned inside the guest")
+			Expect(startGuestIPv6AddressMonitor(hotPluggedVMI)).To(Succeed())
+
+			By("Hotplugging an interface with IPv6 disabled to the VM")
+			network, iface := newNetworkInterface(ifaceName, nadName)
+			iface.DisableIPv6 = true
+			Expect(patchVMWithNewInterface(hotPluggedVM, network, iface)).To(Succeed())
+		})
+
+		DescribeTable("never assigns an IPv6 address to the interface inside the guest", func(plugMethod hotplugMethod) {
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+
+			Eventually(func() error {
+				return checkGuestIfaceHasNoIPv6(hotPluggedVMI, vmIfaceName)
+			}, libnet.HotplugTimeout(), 3*time.Second).Should(Succeed())
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+	})
+
+	Context("a running VM hotplugging an interface with a route-only configuration", func() {
+		const routeDestination = "192.0.2.0/24"
+
+		var hotPluggedVM *v1.VirtualMachine
+		var hotPluggedVMI *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			By("Creating a VM with a route-only configuration for the interface to be hotplugged")
+			networkData, err := libnet.NewNetworkData(
+				libnet.WithEthernet("eth0",
+					libnet.WithDHCP4Enabled(),
+					libnet.WithAddresses(libnet.DefaultIPv6CIDR),
+					libnet.WithGateway6(libnet.DefaultIPv6Gateway),
+					libnet.WithNameserverFromCluster(),
+				),
+				libnet.WithEthernet(vmIfaceName, libnet.WithRoutes(libnet.CloudInitRoute{To: routeDestination, Scope: "link"})),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			vmi := libvmi.NewFedora(
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				libvmi.WithCloudInitNoCloudNetworkData(networkData),
+			)
+			hotPluggedVM, hotPluggedVMI = createRunningVM(tests.NewRandomVirtualMachine(vmi, true), console.LoginToFedora)
+
+			By("Creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+
+			By("Hotplugging an interface to the VM")
+			Expect(addInterface(hotPluggedVM, ifaceName, nadName)).To(Succeed())
+		})
+
+		DescribeTable("configures the route on the interface inside the guest without assigning it an IP", func(plugMethod hotplugMethod) {
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+
+			Eventually(func() error {
+				return checkGuestIfaceRouteOnly(hotPluggedVMI, vmIfaceName, routeDestination)
+			}, libnet.HotplugTimeout(), 3*time.Second).Should(Succeed())
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+	})
+
+	Context("[Serial] a running VM with a custom runtime class and node selector", Serial, decorators.MigrationBasedHotplugNICs, func() {
+		const runtimeClassHandler = "runc"
+
+		var (
+			runtimeClassName string
+			nodeSelector     = map[string]string{k8sv1.LabelOSStable: "linux"}
+			hotPluggedVMI    *v1.VirtualMachineInstance
+		)
+
+		BeforeEach(func() {
+			By("Creating a runtime class")
+			runtimeClassName = "nic-hotplug-runtime-class-" + rand.String(5)
+			_, err := kubevirt.Client().NodeV1().RuntimeClasses().Create(context.Background(), &nodev1.RuntimeClass{
+				ObjectMeta: metav1.ObjectMeta{Name: runtimeClassName},
+				Handler:    runtimeClassHandler,
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() {
+				Expect(kubevirt.Client().NodeV1().RuntimeClasses().Delete(context.Background(), runtimeClassName, metav1.DeleteOptions{})).To(Succeed())
+			})
+
+			By("Configuring a default runtime class and node selector")
+			origConfig := util.GetCurrentKv(kubevirt.Client()).Spec.Configuration
+			config := origConfig.DeepCopy()
+			config.DefaultRuntimeClass = runtimeClassName
+			if config.DeveloperConfiguration == nil {
+				config.DeveloperConfiguration = &v1.DeveloperConfiguration{}
+			}
+			config.DeveloperConfiguration.NodeSelectors = nodeSelector
+			tests.UpdateKubeVirtConfigValueAndWait(*config)
+			DeferCleanup(tests.UpdateKubeVirtConfigValueAndWait, origConfig)
+
+			By("Creating a VM")
+			var hotPluggedVM *v1.VirtualMachine
+			hotPluggedVM, hotPluggedVMI = createRunningVM(newVMWithOneInterface(), console.LoginToAlpine)
+			expectPodToHonorSchedulingConstraints(
+				tests.GetRunningPodByVirtualMachineInstance(hotPluggedVMI, hotPluggedVMI.Namespace), runtimeClassName, nodeSelector)
+
+			By("Restoring the original configuration, so the target pod cannot pick up the constraints from it")
+			tests.UpdateKubeVirtConfigValueAndWait(origConfig)
+
+			By("Creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+
+			By("Hotplugging an interface to the VM")
+			Expect(addInterface(hotPluggedVM, ifaceName, nadName)).To(Succeed())
+		})
+
+		It("plugs the interface into a target pod with the runtime class and node selector of the source pod", func() {
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, migrationBased)
+
+			expectPodToHonorSchedulingConstraints(
+				tests.GetRunningPodByVirtualMachineInstance(hotPluggedVMI, hotPluggedVMI.Namespace), runtimeClassName, nodeSelector)
+		})
+	})
+
+	Context("a running VM whose interfaces are declared by an external controller", func() {
+		const (
+			unpluggedNetworkName = "red"
+			pluggedNetworkName1  = "blue"
+			pluggedNetworkName2  = "green"
+		)
+
+		// sampleInterfacesCR is a sample of a higher level resource, declaring the secondary networks
+		// the VM should be connected to, which is reconciled by an external controller.
+		type sampleInterfacesCR struct {
+			Spec struct {
+				Networks []string
+			}
+		}
+
+		var hotPluggedVM *v1.VirtualMachine
+		var hotPluggedVMI *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			By("Creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+
+			By("Creating a VM with a secondary interface")
+			vm := newVMWithOneInterface()
+			network, iface := newNetworkInterface(unpluggedNetworkName, nadName)
+			vm.Spec.Template.Spec.Networks = append(vm.Spec.Template.Spec.Networks, network)
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = append(vm.Spec.Template.Spec.Domain.Devices.Interfaces, iface)
+			hotPluggedVM, hotPluggedVMI = createRunningVM(vm, console.LoginToAlpine)
+		})
+
+		DescribeTable("reconciles the interfaces to match the desired ones", func(plugMethod hotplugMethod) {
+			unpluggedIfaceStatus := vmispec.LookupInterfaceStatusByName(hotPluggedVMI.Status.Interfaces, unpluggedNetworkName)
+			Expect(unpluggedIfaceStatus).NotTo(BeNil())
+			Expect(unpluggedIfaceStatus.MAC).NotTo(BeEmpty())
+
+			cr := sampleInterfacesCR{}
+			cr.Spec.Networks = []string{pluggedNetworkName1, pluggedNetworkName2}
+
+			By("Declaring the VM interfaces of the sample CR as desired, as an external controller would do")
+			var desiredIfaces []vmispec.DesiredInterface
+			for _, networkName := range cr.Spec.Networks {
+				network, iface := newNetworkInterface(networkName, nadName)
+				desiredIfaces = append(desiredIfaces, vmispec.DesiredInterface{Interface: iface, Network: network})
+			}
+			Expect(declareDesiredInterfaces(hotPluggedVM, desiredIfaces)).To(Succeed())
+
+			By("Waiting for the VMI spec to reflect the two plugs and the unplug")
+			Eventually(func() map[string]v1.InterfaceState {
+				vmi, err := kubevirt.Client().VirtualMachineInstance(hotPluggedVMI.Namespace).Get(context.Background(), hotPluggedVMI.Name, &metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				statesByName := map[string]v1.InterfaceState{}
+				for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+					statesByName[iface.Name] = iface.State
+				}
+				return statesByName
+			}, libnet.HotplugTimeout(), time.Second).Should(Equal(map[string]v1.InterfaceState{
+				"default":            "",
+				unpluggedNetworkName: v1.InterfaceStateAbsent,
+				pluggedNetworkName1:  "",
+				pluggedNetworkName2:  "",
+			}))
+
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+
+			By("Verifying the guest sees the plugged interfaces, and no longer sees the unplugged one")
+			for _, networkName := range cr.Spec.Networks {
+				ifaceStatus := vmispec.LookupInterfaceStatusByName(hotPluggedVMI.Status.Interfaces, networkName)
+				Expect(ifaceStatus).NotTo(BeNil())
+				Eventually(func() error {
+					return checkGuestIfacesWithMAC(hotPluggedVMI, ifaceStatus.MAC, 1)
+				}, libnet.HotplugTimeout(), 3*time.Second).Should(Succeed())
+			}
+			Eventually(func() error {
+				return checkGuestIfacesWithMAC(hotPluggedVMI, unpluggedIfaceStatus.MAC, 0)
+			}, libnet.HotplugTimeout(), 3*time.Second).Should(Succeed())
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+	})
+
+	Context("a running VM hotplugging an interface whose CNI plugin fails", decorators.MigrationBasedHotplugNICs, func() {
+		const missingCNIType = "kubevirt-missing-cni"
+
+		var hotPluggedVMI *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			By("Creating a VM")
+			var hotPluggedVM *v1.VirtualMachine
+			hotPluggedVM, hotPluggedVMI = createRunningVM(newVMWithOneInterface(), console.LoginToAlpine)
+
+			By("Creating a NAD whose CNI plugin does not exist")
+			Expect(createNetworkAttachmentDefinition(
+				kubevirt.Client(),
+				nadName,
+				testsuite.GetTestNamespace(nil),
+				fmt.Sprintf(linuxBridgeNAD, nadName, testsuite.GetTestNamespace(nil), missingCNIType, linuxBridgeName),
+			)).To(Succeed())
+
+			By("Hotplugging an interface to the VM")
+			Expect(addInterface(hotPluggedVM, ifaceName, nadName)).To(Succeed())
+		})
+
+		It("records the CNI failure of the migration target pod on the VMI", func() {
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+
+			By("Migrating the VMI, so the interface is plugged into the migration target pod")
+			tests.RunMigration(kubevirt.Client(), tests.NewRandomMigration(hotPluggedVMI.Name, hotPluggedVMI.Namespace))
+
+			events.ExpectEvent(hotPluggedVMI, k8sv1.EventTypeWarning, watch.FailedPlugInterfaceReason)
+		})
+	})
+
+	Context("a running VM with a connected guest agent", decorators.InPlaceHotplugNICs, func() {
+		var hotPluggedVM *v1.VirtualMachine
+		var hotPluggedVMI *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			By("Creating a VM")
+			vm := tests.NewRandomVirtualMachine(libvmi.NewFedora(libvmi.WithMasqueradeNetworking()...), true)
+			hotPluggedVM, hotPluggedVMI = createRunningVM(vm, console.LoginToFedora)
+			Eventually(matcher.ThisVMI(hotPluggedVMI), libnet.GuestAgentConnectionTimeout(), 2*time.Second).Should(matcher.HaveConditionTrue(v1.VirtualMachineInstanceAgentConnected))
+
+			By("Creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+		})
+
+		It("keeps the guest agent connected while an interface is hotplugged", func() {
+			stopMonitor := monitorGuestAgentConnection(hotPluggedVMI)
+
+			By("Hotplugging an interface to the VM")
+			Expect(addInterface(hotPluggedVM, ifaceName, nadName)).To(Succeed())
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, inPlace)
+			Expect(libnet.InterfaceExists(hotPluggedVMI, vmIfaceName)).To(Succeed())
+
+			By("Waiting for the guest agent to report the hotplugged interface")
+			Eventually(func() string {
+				vmi, err := kubevirt.Client().VirtualMachineInstance(hotPluggedVMI.Namespace).Get(context.Background(), hotPluggedVMI.Name, &metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, ifaceName)
+				if ifaceStatus == nil {
+					return ""
+				}
+				return ifaceStatus.InterfaceName
+			}, libnet.HotplugTimeout(), time.Second).Should(Equal(vmIfaceName))
+
+			Expect(stopMonitor()).To(BeEmpty(), "the guest agent should stay connected during the hotplug")
+		})
+	})
+
+	Context("a running VM hotplugging an interface with a network name exceeding the interface name limit", func() {
+		const (
+			longNetworkName = "network-name-20chars"
+
+			// The host device names are derived from the hash of the network name, they must not change
+			// across releases, since the devices of running VMs are looked up by them.
+			expectedPodIfaceName = "pod719c1c3b443"
+			expectedBridgeName   = "k6t-719c1c3b443"
+			expectedTapName      = "tap719c1c3b443"
+		)
+
+		var hotPluggedVM *v1.VirtualMachine
+		var hotPluggedVMI *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			By("Creating a VM")
+			hotPluggedVM, hotPluggedVMI = createRunningVM(newVMWithOneInterface(), console.LoginToAlpine)
+
+			By("Creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+
+			By("Hotplugging an interface with a long network name to the VM")
+			Expect(addInterface(hotPluggedVM, longNetworkName, nadName)).To(Succeed())
+		})
+
+		DescribeTable("plugs the interface using valid and stable host device names", func(plugMethod hotplugMethod) {
+			Eventually(func() *v1.Interface {
+				var err error
+				hotPluggedVMI, err = kubevirt.Client().VirtualMachineInstance(hotPluggedVMI.Namespace).Get(context.Background(), hotPluggedVMI.Name, &metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				return vmispec.LookupInterfaceByName(hotPluggedVMI.Spec.Domain.Devices.Interfaces, longNetworkName)
+			}, libnet.HotplugTimeout()).ShouldNot(BeNil())
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+			Expect(libnet.InterfaceExists(hotPluggedVMI, vmIfaceName)).To(Succeed())
+
+			launcherPod := tests.GetRunningPodByVirtualMachineInstance(hotPluggedVMI, hotPluggedVMI.Namespace)
+			Eventually(func() error {
+				return checkHostDeviceNames(launcherPod, expectedPodIfaceName, expectedBridgeName, expectedTapName)
+			}, libnet.HotplugTimeout(), time.Second).Should(Succeed())
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+	})
+
+	Context("a running VM hotplugging an interface with proxy ARP", func() {
+		var hotPluggedVM *v1.VirtualMachine
+		var hotPluggedVMI *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			By("Creating a VM")
+			hotPluggedVM, hotPluggedVMI = createRunningVM(newVMWithOneInterface(), console.LoginToAlpine)
+
+			By("Creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+
+			By("Hotplugging an interface with proxy ARP to the VM")
+			network, iface := newNetworkInterface(ifaceName, nadName)
+			iface.ProxyARP = true
+			Expect(patchVMWithNewInterface(hotPluggedVM, network, iface)).To(Succeed())
+		})
+
+		DescribeTable("answers ARP requests of the guest on behalf of off-link addresses", func(plugMethod hotplugMethod) {
+			const (
+				guestIfaceAddress = "10.1.1.1"
+				// An address routed by the virt-launcher pod through its primary interface,
+				// which no host on the bridge network owns. The pod forwards the traffic to it,
+				// as forwarding is enabled in the pod by the masquerade binding of the VM.
+				offLinkAddress = "192.0.2.1"
+			)
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, plugMethod)
+			Expect(libnet.InterfaceExists(hotPluggedVMI, vmIfaceName)).To(Succeed())
+			Expect(configInterface(hotPluggedVMI, vmIfaceName, guestIfaceAddress+"/24")).To(Succeed())
+
+			By("sending an ARP request for an off-link address from the guest")
+			Expect(runSafeCommand(hotPluggedVMI,
+				fmt.Sprintf("arping -c 3 -w 10 -I %s -s %s %s\n", vmIfaceName, guestIfaceAddress, offLinkAddress),
+			)).To(Succeed(), "the in-pod bridge should reply on behalf of the off-link address")
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+	})
+
+	Context("a running VM paused during the hotplug migration", decorators.MigrationBasedHotplugNICs, func() {
+		var hotPluggedVM *v1.VirtualMachine
+		var hotPluggedVMI *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi := libvmi.NewAlpineWithTestTooling()
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+
+			By("Creating a migration policy limiting the bandwidth, so the VM can be paused while migrating")
+			tests.CreateMigrationPolicy(kubevirt.Client(), tests.PreparePolicyAndVMIWithBandwidthLimitation(vmi, resource.MustParse("5Mi")))
+
+			By("Creating a VM")
+			hotPluggedVM, hotPluggedVMI = createRunningVM(tests.NewRandomVirtualMachine(vmi, true), console.LoginToAlpine)
+
+			By("Creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+
+			By("Hotplugging an interface to the VM")
+			Expect(addInterface(hotPluggedVM, ifaceName, nadName)).To(Succeed())
+			waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+		})
+
+		It("realizes the hotplugged interface once the VM is resumed", func() {
+			migrateWhilePausedAndResume(hotPluggedVMI)
+
+			hotPluggedVMI = verifyDynamicInterfaceChange(hotPluggedVMI, migrationBased)
+			Expect(libnet.InterfaceExists(hotPluggedVMI, vmIfaceName)).To(Succeed())
+		})
+	})
+
+	Context("[Serial] running VMs with eager hotplug migration", Serial, func() {
+		var hotPluggedVM *v1.VirtualMachine
+		var hotPluggedVMI *v1.VirtualMachineInstance
+
+		setInPlaceHotplugTimeout := func(timeout time.Duration) {
+			origConfig := util.GetCurrentKv(kubevirt.Client()).Spec.Configuration
+			config := origConfig.DeepCopy()
+			if config.NetworkConfiguration == nil {
+				config.NetworkConfiguration = &v1.NetworkConfiguration{}
+			}
+			config.NetworkConfiguration.InPlaceHotplugTimeout = &metav1.Duration{Duration: timeout}
+			tests.UpdateKubeVirtConfigValueAndWait(*config)
+			DeferCleanup(tests.UpdateKubeVirtConfigValueAndWait, origConfig)
+		}
+
+		waitForPluggedIface := func(vmi *v1.VirtualMachineInstance, timeout time.Duration) {
+			Eventually(func() []v1.VirtualMachineInstanceNetworkInterface {
+				return cleanMACAddressesFromStatus(vmiCurrentInterfaces(vmi.GetNamespace(), vmi.GetName()))
+			}, timeout).Should(ConsistOf(interfaceStatusFromInterfaceNames(ifaceName)))
+		}
+
+		BeforeEach(func() {
+			By("Enabling the eager hotplug migration")
+			tests.EnableFeatureGate(virtconfig.HotplugNetworkIfacesEagerMigrationGate)
+			DeferCleanup(tests.DisableFeatureGate, virtconfig.HotplugNetworkIfacesEagerMigrationGate)
+
+			By("Creating a VM")
+			hotPluggedVM, hotPluggedVMI = createRunningVM(newVMWithOneInterface(), console.LoginToAlpine)
+
+			By("Creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+		})
+
+		It("plugs an interface in place within the timeout, without migrating the VM", decorators.InPlaceHotplugNICs, func() {
+			By("Hotplugging an interface")
+			Expect(addInterface(hotPluggedVM, ifaceName, nadName)).To(Succeed())
+			hotPluggedVMI = waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+			waitForPluggedIface(hotPluggedVMI, virtconfig.DefaultInPlaceHotplugTimeout)
+
+			By("Expecting no migration of the VM")
+			migrations, err := kubevirt.Client().VirtualMachineInstanceMigration(hotPluggedVMI.Namespace).List(&metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			for _, migration := range migrations.Items {
+				Expect(migration.Spec.VMIName).ToNot(Equal(hotPluggedVMI.Name))
+			}
+
+			pods, err := kubevirt.Client().CoreV1().Pods(hotPluggedVMI.Namespace).List(context.Background(), metav1.ListOptions{
+				LabelSelector: v1.CreatedByLabel + "=" + string(hotPluggedVMI.GetUID()),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(HaveLen(1), "no migration target pod should be created")
+		})
+
+		It("migrates the VM once the in-place hotplug timeout passed", decorators.MigrationBasedHotplugNICs, func() {
+			const inPlaceHotplugTimeout = 30 * time.Second
+			setInPlaceHotplugTimeout(inPlaceHotplugTimeout)
+
+			By("Hotplugging an interface")
+			Expect(addInterface(hotPluggedVM, ifaceName, nadName)).To(Succeed())
+			hotPluggedVMI = waitForSingleHotPlugIfaceOnVMISpec(hotPluggedVMI)
+
+			By("Expecting the VM to be migrated once the timeout passed")
+			waitForPluggedIface(hotPluggedVMI, inPlaceHotplugTimeout+libnet.VMIRestartTimeout())
+			events.ExpectEvent(hotPluggedVMI, k8sv1.EventTypeNormal, watch.SuccessfulCreateHotplugMigrationReason)
+		})
+	})
+})
+
+var _ = SIGDescribe("nic-hotunplug", func() {
+	const (
+		linuxBridgeNetworkName1 = "red"
+		linuxBridgeNetworkName2 = "blue"
+	)
+
+	BeforeEach(func() {
+		Expect(checks.HasFeature(virtconfig.HotplugNetworkIfacesGate)).To(BeTrue())
+	})
+
+	Context("a running VM", func() {
+		var vm *v1.VirtualMachine
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			By("creating a NAD")
+			Expect(createBridgeNetworkAttachmentDefinition(
+				testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+
+			By("running a VM")
+			opts := append(
+				libvmi.WithMasqueradeNetworking(),
+				libvmi.WithNetwork(libvmi.MultusNetwork(linuxBridgeNetworkName1, nadName)),
+				libvmi.WithNetwork(libvmi.MultusNetwork(linuxBridgeNetworkName2, nadName)),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(linuxBridgeNetworkName1)),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(linuxBridgeNetworkName2)),
+			)
+			vmi = libvmi.NewAlpineWithTestTooling(opts...)
+			vm = tests.NewRandomVirtualMachine(vmi, true)
+
+			var err error
+			vm, err = kubevirt.Client().VirtualMachine(testsuite.GetTestNamespace(nil)).Create(context.Background(), vm)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() error {
+				vmi, err = kubevirt.Client().VirtualMachineInstance(vm.Namespace).Get(context.Background(), vm.Name, &metav1.GetOptions{})
+				return err
+			}, libnet.VMICreationTimeout(), 1*time.Second).ShouldNot(HaveOccurred())
+
+			libwait.WaitUntilVMIReady(vmi, console.LoginToAlpine)
+		})
+
+		It("does not update the VMI while the pod still reports the unplugged interface", decorators.InPlaceHotplugNICs, func() {
+			launcherPod := tests.GetRunningPodByVirtualMachineInstance(vmi, vmi.Namespace)
+			unpluggedPodIfaceName := namescheme.HashedPodInterfaceName(*libvmi.MultusNetwork(linuxBridgeNetworkName2, nadName))
+			unpluggedPodIfaceStatus, err := lookupPodNetworkStatus(launcherPod, unpluggedPodIfaceName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unpluggedPodIfaceStatus).NotTo(BeNil())
+
+			Expect(removeInterface(vm, linuxBridgeNetworkName2)).To(Succeed())
+			Eventually(func() v1.InterfaceState {
+				vmi, err = kubevirt.Client().VirtualMachineInstance(vmi.Namespace).Get(context.Background(), vmi.Name, &metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				return vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, linuxBridgeNetworkName2).State
+			}, libnet.HotplugTimeout()).Should(Equal(v1.InterfaceStateAbsent))
+			vmi = verifyDynamicInterfaceChange(vmi, inPlace)
+
+			By("waiting for the pod to stop reporting the unplugged interface")
+			Eventually(func() (*k8snetworkplumbingwgv1.NetworkStatus, error) {
+				launcherPod = tests.GetRunningPodByVirtualMachineInstance(vmi, vmi.Namespace)
+				return lookupPodNetworkStatus(launcherPod, unpluggedPodIfaceName)
+			}, libnet.HotplugTimeout(), time.Second).Should(BeNil())
+
+			By("reporting the unplugged interface on the pod again, as a pod with a stale network status would")
+			Expect(appendPodNetworkStatus(launcherPod, *unpluggedPodIfaceStatus)).To(Succeed())
+
+			assertNoVMIUpdatesAtSteadyState(vmi)
+		})
+
+		DescribeTable("hot-unplug network interface succeed", func(plugMethod hotplugMethod) {
+			Expect(removeInterface(vm, linuxBridgeNetworkName2)).To(Succeed())
+
+			By("wait for requested interface VMI spec to have 'absent' state")
+			Eventually(func() v1.InterfaceState {
+				var err error
+				vmi, err = kubevirt.Client().VirtualMachineInstance(vmi.Namespace).Get(context.Background(), vmi.Name, &metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, linuxBridgeNetworkName2)
+				return iface.State
+			}, libnet.HotplugTimeout()).Should(Equal(v1.InterfaceStateAbsent))
+
+			By("verify unplugged interface is not reported in the VMI status")
+			vmi = verifyDynamicInterfaceChange(vmi, plugMethod)
+
+			By("restarting the VM")
+			Expect(kubevirt.Client().VirtualMachine(vm.Namespace).Restart(context.Background(), vm.Name, &v1.RestartOptions{})).To(Succeed())
+
+			By("wait for new VMI to start")
+			var newVMI *v1.VirtualMachineInstance
+			Eventually(func() error {
+				var err error
+				newVMI, err = kubevirt.Client().VirtualMachineInstance(vm.Namespace).Get(context.Background(), vm.Name, &metav1.GetOptions{})
+				if err != nil || vmi.UID == newVMI.UID {
+					return fmt.Errorf("vmi did not create yet")
+				}
+				return nil
+			}, libnet.VMIRestartTimeout(), 1*time.Second).Should(Succeed())
+			libwait.WaitUntilVMIReady(newVMI, console.LoginToAlpine)
+
+			_ = verifyDynamicInterfaceChange(newVMI, plugMethod)
+		},
+			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
+			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
+		)
+
+		It("restores the promiscuous mode of the pod and node bridges after unplug", decorators.InPlaceHotplugNICs, func() {
+			unpluggedNetwork := libvmi.MultusNetwork(linuxBridgeNetworkName2, nadName)
+			podIfaceName := namescheme.HashedPodInterfaceName(*unpluggedNetwork)
+			launcherPod := tests.GetRunningPodByVirtualMachineInstance(vmi, vmi.Namespace)
+
+			By("verifying the pod interface is in promiscuous mode while it is a bridge port")
+			Expect(isPodLinkPromisc(launcherPod, podIfaceName)).To(BeTrue())
+			nodeBridgePromisc := isNodeLinkPromisc(vmi.Status.NodeName, linuxBridgeName)
+
+			Expect(removeInterface(vm, linuxBridgeNetworkName2)).To(Succeed())
+			Eventually(func() v1.InterfaceState {
+				var err error
+				vmi, err = kubevirt.Client().VirtualMachineInstance(vmi.Namespace).Get(context.Background(), vmi.Name, &metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				return vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, linuxBridgeNetworkName2).State
+			}, libnet.HotplugTimeout()).Should(Equal(v1.InterfaceStateAbsent))
+			vmi = verifyDynamicInterfaceChange(vmi, inPlace)
+
+			By("verifying the in-pod bridge, the tap and the pod interface are removed")
+			for _, linkName := range []string{
+				virtnetlink.GenerateBridgeName(podIfaceName),
+				virtnetlink.GenerateTapDeviceName(podIfaceName),
+				podIfaceName,
+			} {
+				Eventually(func() bool {
+					return podLinkExists(launcherPod, linkName)
+				}, libnet.HotplugTimeout(), time.Second).Should(BeFalse(), "link %s should be removed from the pod", linkName)
+			}
+
+			By("verifying the promiscuous mode of the node bridge is restored")
+			Expect(isNodeLinkPromisc(vmi.Status.NodeName, linuxBridgeName)).To(Equal(nodeBridgePromisc))
+		})
+	})
+
+	Context("[Serial] a running VM whose network-attachment-definition is deleted", Serial, func() {
+		const deletedNADName = "skynet-to-delete"
+
+		var vm *v1.VirtualMachine
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			By("enabling the removal of interfaces of deleted networks")
+			origConfig := util.GetCurrentKv(kubevirt.Client()).Spec.Configuration
+			config := origConfig.DeepCopy()
+			if config.NetworkConfiguration == nil {
+				config.NetworkConfiguration = &v1.NetworkConfiguration{}
+			}
+			config.NetworkConfiguration.UnplugInterfacesOfDeletedNetworks = pointer.Bool(true)
+			tests.UpdateKubeVirtConfigValueAndWait(*config)
+			DeferCleanup(tests.UpdateKubeVirtConfigValueAndWait, origConfig)
+
+			By("creating the NADs")
+			Expect(createBridgeNetworkAttachmentDefinition(
+				testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
+			Expect(createBridgeNetworkAttachmentDefinition(
+				testsuite.GetTestNamespace(nil), deletedNADName, linuxBridgeName)).To(Succeed())
+
+			By("running a VM")
+			opts := append(
+				libvmi.WithMasqueradeNetworking(),
+				libvmi.WithNetwork(libvmi.MultusNetwork(linuxBridgeNetworkName1, nadName)),
+				libvmi.WithNetwork(libvmi.MultusNetwork(linuxBridgeNetworkName2, deletedNADName)),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(linuxBridgeNetworkName1)),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(linuxBridgeNetworkName2)),
+			)
+			vm, vmi = createRunningVM(tests.NewRandomVirtualMachine(libvmi.NewAlpineWithTestTooling(opts...), true), console.LoginToAlpine)
+		})
+
+		DescribeTable("reports the missing network and unplugs its interface", func(plugMethod hotplugMethod) {
+			By("deleting the NAD")
+			Expect(kubevirt.Client().NetworkClient().K8sCniCncfIoV1().NetworkAttachmentDefinitions(vm.Namespace).Delete(
+				context.Background(), deletedNADName, metav1.DeleteOptions{})).To(Succeed())
+
+			By("wait for the VM to report the missing network")
+			Eventually(matcher.ThisVM(vm), libnet.HotplugTimeout()).
+				Should(matcher.HaveConditionTrue(v1.VirtualMachineNetworkAttachmentDefinitionMissing))
+
+			By("wait for the interface of the deleted network to have 'absent' state on the VM and VMI specs")
+			Eventually(func(g Gomega) {
+				updatedVM, err := kubevirt.Client().VirtualMachine(vm.Namespace).Get(context.Background(), vm.Name, &metav1.GetOptions{})
+				g.Expect(err).NotTo(HaveOccurred())
+				iface := vmispec.LookupInterfaceByName(updatedVM.Spec.Template.Spec.Domain.Devices.Interfaces, linuxBridgeNetworkName2)
+				g.Expect(iface.State).To(Equal(v1.InterfaceStateAbsent))
+
+				vmi, err = kubevirt.Client().VirtualMachineInstance(vmi.Namespace).Get(context.Background(), vmi.Name, &metav1.GetOptions{})
+				g.Expect(err).NotTo(HaveOccurred())
+				iface = vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, linuxBridgeNetworkName2)
+				g.Expect(iface.State).To(Equal(v1.InterfaceStateAbsent))
+			}, libnet.HotplugTimeout(), time.Second).Should(Succeed())
+
+			By("verify unplugged interface is not reported in the VMI status")
+			vmi = verifyDynamicInterfaceChange(vmi, plugMethod)
+			Expect(vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, linuxBridgeNetworkName1)).NotTo(BeNil())
+
+			By("wait for the VM to stop reporting the missing network")
+			Eventually(matcher.ThisVM(vm), libnet.HotplugTimeout()).
+				Should(matcher.HaveConditionMissingOrFalse(v1.VirtualMachineNetworkAttachmentDefinitionMissing))
 		},
 			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
 			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
 		)
 	})
-})
-
-var _ = SIGDescribe("nic-hotunplug", func() {
-	const (
-		linuxBridgeNetworkName1 = "red"
-		linuxBridgeNetworkName2 = "blue"
-	)
-
-	BeforeEach(func() {
-		Expect(checks.HasFeature(virtconfig.HotplugNetworkIfacesGate)).To(BeTrue())
-	})
 
-	Context("a running VM", func() {
+	Context("[Serial] a running VM retaining the MAC of unplugged interfaces", Serial, func() {
 		var vm *v1.VirtualMachine
 		var vmi *v1.VirtualMachineInstance
 
 		BeforeEach(func() {
+			By("enabling retaining the MAC of unplugged interfaces")
+			origConfig := util.GetCurrentKv(kubevirt.Client()).Spec.Configuration
+			config := origConfig.DeepCopy()
+			if config.NetworkConfiguration == nil {
+				config.NetworkConfiguration = &v1.NetworkConfiguration{}
+			}
+			config.NetworkConfiguration.RetainMACOnUnplug = pointer.Bool(true)
+			tests.UpdateKubeVirtConfigValueAndWait(*config)
+			DeferCleanup(tests.UpdateKubeVirtConfigValueAndWait, origConfig)
+
 			By("creating a NAD")
 			Expect(createBridgeNetworkAttachmentDefinition(
 				testsuite.GetTestNamespace(nil), nadName, linuxBridgeName)).To(Succeed())
@@ -277,51 +1132,51 @@ var _ = SIGDescribe("nic-hotunplug", func() {
 				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(linuxBridgeNetworkName1)),
 				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(linuxBridgeNetworkName2)),
 			)
-			vmi = libvmi.NewAlpineWithTestTooling(opts...)
-			vm = tests.NewRandomVirtualMachine(vmi, true)
-
-			var err error
-			vm, err = kubevirt.Client().VirtualMachine(testsuite.GetTestNamespace(nil)).Create(context.Background(), vm)
-			Expect(err).NotTo(HaveOccurred())
-			Eventually(func() error {
-				vmi, err = kubevirt.Client().VirtualMachineInstance(vm.Namespace).Get(context.Background(), vm.Name, &metav1.GetOptions{})
-				return err
-			}, 120*time.Second, 1*time.Second).ShouldNot(HaveOccurred())
-
-			libwait.WaitUntilVMIReady(vmi, console.LoginToAlpine)
+			vm, vmi = createRunningVM(tests.NewRandomVirtualMachine(libvmi.NewAlpineWithTestTooling(opts...), true), console.LoginToAlpine)
 		})
 
-		DescribeTable("hot-unplug network interface succeed", func(plugMethod hotplugMethod) {
-			Expect(removeInterface(vm, linuxBridgeNetworkName2)).To(Succeed())
+		DescribeTable("reuses the MAC when an interface is plugged again under the same name", func(plugMethod hotplugMethod) {
+			ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, linuxBridgeNetworkName2)
+			Expect(ifaceStatus).NotTo(BeNil())
+			Expect(ifaceStatus.MAC).NotTo(BeEmpty())
+			originalMAC := ifaceStatus.MAC
 
-			By("wait for requested interface VMI spec to have 'absent' state")
+			By("unplugging the interface")
+			Expect(removeInterface(vm, linuxBridgeNetworkName2)).To(Succeed())
 			Eventually(func() v1.InterfaceState {
 				var err error
 				vmi, err = kubevirt.Client().VirtualMachineInstance(vmi.Namespace).Get(context.Background(), vmi.Name, &metav1.GetOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, linuxBridgeNetworkName2)
-				return iface.State
-			}, 30*time.Second).Should(Equal(v1.InterfaceStateAbsent))
-
-			By("verify unplugged interface is not reported in the VMI status")
+				return vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, linuxBridgeNetworkName2).State
+			}, libnet.HotplugTimeout()).Should(Equal(v1.InterfaceStateAbsent))
 			vmi = verifyDynamicInterfaceChange(vmi, plugMethod)
 
-			By("restarting the VM")
-			Expect(kubevirt.Client().VirtualMachine(vm.Namespace).Restart(context.Background(), vm.Name, &v1.RestartOptions{})).To(Succeed())
+			By("verifying the MAC of the unplugged interface is retained on the VM")
+			Eventually(func(g Gomega) {
+				var err error
+				vm, err = kubevirt.Client().VirtualMachine(vm.Namespace).Get(context.Background(), vm.Name, &metav1.GetOptions{})
+				g.Expect(err).NotTo(HaveOccurred())
+				iface := vmispec.LookupInterfaceByName(vm.Spec.Template.Spec.Domain.Devices.Interfaces, linuxBridgeNetworkName2)
+				g.Expect(iface.MacAddress).To(Equal(originalMAC))
+			}, libnet.HotplugTimeout(), time.Second).Should(Succeed())
 
-			By("wait for new VMI to start")
-			var newVMI *v1.VirtualMachineInstance
-			Eventually(func() error {
+			By("plugging the interface again under the same name")
+			Expect(plugInterfaceAgain(vm, linuxBridgeNetworkName2)).To(Succeed())
+			Eventually(func() v1.InterfaceState {
 				var err error
-				newVMI, err = kubevirt.Client().VirtualMachineInstance(vm.Namespace).Get(context.Background(), vm.Name, &metav1.GetOptions{})
-				if err != nil || vmi.UID == newVMI.UID {
-					return fmt.Errorf("vmi did not create yet")
-				}
-				return nil
-			}, 90*time.Second, 1*time.Second).Should(Succeed())
-			libwait.WaitUntilVMIReady(newVMI, console.LoginToAlpine)
+				vmi, err = kubevirt.Client().VirtualMachineInstance(vmi.Namespace).Get(context.Background(), vmi.Name, &metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				return vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, linuxBridgeNetworkName2).State
+			}, libnet.HotplugTimeout()).Should(BeEmpty())
+			vmi = verifyDynamicInterfaceChange(vmi, plugMethod)
 
-			_ = verifyDynamicInterfaceChange(newVMI, plugMethod)
+			By("verifying the plugged interface reuses the retained MAC")
+			Eventually(func(g Gomega) {
+				ifaces := vmiCurrentInterfaces(vmi.Namespace, vmi.Name)
+				ifaceStatus := vmispec.LookupInterfaceStatusByName(ifaces, linuxBridgeNetworkName2)
+				g.Expect(ifaceStatus).NotTo(BeNil())
+				g.Expect(ifaceStatus.MAC).To(Equal(originalMAC))
+			}, libnet.HotplugTimeout(), time.Second).Should(Succeed())
 		},
 			Entry("In place", decorators.InPlaceHotplugNICs, inPlace),
 			Entry("Migration based", decorators.MigrationBasedHotplugNICs, migrationBased),
@@ -360,7 +1215,7 @@ var _ = SIGDescribe("nic-hotunplug", func() {
 				Expect(err).NotTo(HaveOccurred())
 				iface := vmispec.LookupInterfaceByName(vm.Spec.Template.Spec.Domain.Devices.Interfaces, linuxBridgeNetworkName2)
 				return iface.State
-			}, 30*time.Second).Should(Equal(v1.InterfaceStateAbsent))
+			}, libnet.HotplugTimeout()).Should(Equal(v1.InterfaceStateAbsent))
 
 			Expect(previousVMTemplateSpec.Networks).To(Equal(vm.Spec.Template.Spec.Networks), "network spec should not change")
 		})
@@ -386,7 +1241,7 @@ func verifyDynamicInterfaceChange(vmi *v1.VirtualMachineInstance, plugMethod hot
 	ExpectWithOffset(1, secondaryNetworksNames).NotTo(BeEmpty())
 	EventuallyWithOffset(1, func() []v1.VirtualMachineInstanceNetworkInterface {
 		return cleanMACAddressesFromStatus(vmiCurrentInterfaces(vmi.GetNamespace(), vmi.GetName()))
-	}, 30*time.Second).Should(
+	}, libnet.HotplugTimeout()).Should(
 		ConsistOf(interfaceStatusFromInterfaceNames(secondaryNetworksNames...)))
 
 	vmi, err = kubevirt.Client().VirtualMachineInstance(vmi.GetNamespace()).Get(context.Background(), vmi.GetName(), &metav1.GetOptions{})
@@ -400,7 +1255,7 @@ func waitForSingleHotPlugIfaceOnVMISpec(vmi *v1.VirtualMachineInstance) *v1.Virt
 		vmi, err = kubevirt.Client().VirtualMachineInstance(vmi.GetNamespace()).Get(context.Background(), vmi.GetName(), &metav1.GetOptions{})
 		Expect(err).NotTo(HaveOccurred())
 		return vmi.Spec.Networks
-	}, 30*time.Second).Should(
+	}, libnet.HotplugTimeout()).Should(
 		ConsistOf(
 			*v1.DefaultPodNetwork(),
 			v1.Network{
@@ -413,12 +1268,61 @@ func waitForSingleHotPlugIfaceOnVMISpec(vmi *v1.VirtualMachineInstance) *v1.Virt
 	return vmi
 }
 
+func waitForVMObservedGeneration(vm *v1.VirtualMachine) {
+	EventuallyWithOffset(1, func(g Gomega) {
+		updatedVM, err := kubevirt.Client().VirtualMachine(vm.Namespace).Get(context.Background(), vm.Name, &metav1.GetOptions{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(updatedVM.Generation).To(BeNumerically(">", vm.Generation), "VM generation should advance on hotplug")
+		g.Expect(updatedVM.Status.ObservedGeneration).To(Equal(updatedVM.Generation))
+		g.Expect(updatedVM.Status.DesiredGeneration).To(Equal(updatedVM.Generation))
+	}, libnet.HotplugTimeout(), time.Second).Should(Succeed())
+}
+
+func expectPodToHonorSchedulingConstraints(pod *k8sv1.Pod, runtimeClassName string, nodeSelector map[string]string) {
+	ExpectWithOffset(1, pod.Spec.RuntimeClassName).To(HaveValue(Equal(runtimeClassName)),
+		"pod %s should run with runtime class %s", pod.Name, runtimeClassName)
+	for key, value := range nodeSelector {
+		ExpectWithOffset(1, pod.Spec.NodeSelector).To(HaveKeyWithValue(key, value),
+			"pod %s should be scheduled using node selector %s=%s", pod.Name, key, value)
+	}
+}
+
 func vmiCurrentInterfaces(vmiNamespace, vmiName string) []v1.VirtualMachineInstanceNetworkInterface {
 	vmi, err := kubevirt.Client().VirtualMachineInstance(vmiNamespace).Get(context.Background(), vmiName, &metav1.GetOptions{})
 	ExpectWithOffset(2, err).NotTo(HaveOccurred())
 	return secondaryInterfaces(vmi)
 }
 
+// assertNoVMIUpdatesAtSteadyState waits for the VMI to settle, and then asserts its resource version
+// does not change for a while, as the VMI is not updated when nothing changes.
+func assertNoVMIUpdatesAtSteadyState(vmi *v1.VirtualMachineInstance) {
+	const (
+		pollInterval    = 5 * time.Second
+		observeDuration = 20 * time.Second
+	)
+
+	getResourceVersion := func() (string, error) {
+		currentVMI, err := kubevirt.Client().VirtualMachineInstance(vmi.Namespace).Get(context.Background(), vmi.Name, &metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return currentVMI.ResourceVersion, nil
+	}
+
+	var settledResourceVersion string
+	EventuallyWithOffset(1, func(g Gomega) {
+		previousResourceVersion := settledResourceVersion
+		var err error
+		settledResourceVersion, err = getResourceVersion()
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(settledResourceVersion).To(Equal(previousResourceVersion))
+	}, libnet.HotplugTimeout(), pollInterval).Should(Succeed())
+
+	By("verifying the VMI is not updated at steady state")
+	ConsistentlyWithOffset(1, getResourceVersion, observeDuration, pollInterval).Should(Equal(settledResourceVersion),
+		"the VMI should not be updated at steady state")
+}
+
 func createBridgeNetworkAttachmentDefinition(namespace, networkName string, bridgeName string) error {
 	return createNetworkAttachmentDefinition(
 		kubevirt.Client(),
@@ -472,6 +1376,353 @@ func interfaceStatusFromInterfaceNames(ifaceNames ...string) []v1.VirtualMachine
 	return ifaceStatus
 }
 
+// runVMIConnectedToSecondaryNetwork runs a VMI on the given node, connected to the pod network and to the
+// hotplug test secondary network, with the given static address configured on the secondary interface.
+func runVMIConnectedToSecondaryNetwork(nodeName, secondaryIfaceAddress string) *v1.VirtualMachineInstance {
+	net := v1.Network{
+		Name: ifaceName,
+		NetworkSource: v1.NetworkSource{
+			Multus: &v1.MultusNetwork{
+				NetworkName: nadName,
+			},
+		},
+	}
+
+	iface := v1.Interface{
+		Name: ifaceName,
+		InterfaceBindingMethod: v1.InterfaceBindingMethod{
+			Bridge: &v1.InterfaceBridge{},
+		},
+	}
+
+	vmi := libvmi.NewFedora(
+		libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+		libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		libvmi.WithInterface(iface),
+		libvmi.WithNetwork(&net),
+		libvmi.WithCloudInitNoCloudNetworkData(cloudInitNetworkDataWithStaticIPsByDevice("eth1", secondaryIfaceAddress)))
+	vmi = tests.CreateVmiOnNode(vmi, nodeName)
+	return libwait.WaitUntilVMIReady(vmi, console.LoginToFedora)
+}
+
+// assertPrimaryAndSecondaryConnectivity asserts the VMI reaches its masquerade gateway (derived from the
+// given masquerade CIDR) and the peer VMI over the primary network, and the peer over the secondary network.
+func assertPrimaryAndSecondaryConnectivity(vmi *v1.VirtualMachineInstance, masqueradeCIDR string, peerVMI *v1.VirtualMachineInstance, peerSecondaryIP string) {
+	By("Ping from the VM with hotplugged interface to its masquerade gateway")
+	ExpectWithOffset(1, libnet.PingFromVMConsole(vmi, gatewayIPFromCIDR(masqueradeCIDR))).To(Succeed())
+
+	By("Ping from the VM with hotplugged interface to the other VM over the primary network")
+	peerPrimaryIP := libnet.GetVmiPrimaryIPByFamily(peerVMI, k8sv1.IPv4Protocol)
+	ExpectWithOffset(1, peerPrimaryIP).NotTo(BeEmpty())
+	ExpectWithOffset(1, libnet.PingFromVMConsole(vmi, peerPrimaryIP)).To(Succeed())
+
+	By("Ping from the VM with hotplugged interface to the other VM over the secondary network")
+	ExpectWithOffset(1, libnet.PingFromVMConsole(vmi, peerSecondaryIP)).To(Succeed())
+}
+
+// createRunningVM creates the given running VM, and waits for its VMI to be ready
+func createRunningVM(vm *v1.VirtualMachine, loginTo console.LoginToFunction) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+	vm, err := kubevirt.Client().VirtualMachine(testsuite.GetTestNamespace(nil)).Create(context.Background(), vm)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	var vmi *v1.VirtualMachineInstance
+	EventuallyWithOffset(1, func() error {
+		var err error
+		vmi, err = kubevirt.Client().VirtualMachineInstance(vm.Namespace).Get(context.Background(), vm.Name, &metav1.GetOptions{})
+		return err
+	}, libnet.VMICreationTimeout(), 1*time.Second).ShouldNot(HaveOccurred())
+
+	return vm, libwait.WaitUntilVMIReady(vmi, loginTo)
+}
+
+// createVMAndWaitForRunningPhase creates the given running VM, and returns its VMI as soon
+// as it reaches the running phase, without waiting for the guest to boot
+func createVMAndWaitForRunningPhase(vm *v1.VirtualMachine) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+	vm, err := kubevirt.Client().VirtualMachine(testsuite.GetTestNamespace(nil)).Create(context.Background(), vm)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	var vmi *v1.VirtualMachineInstance
+	EventuallyWithOffset(1, func() (*v1.VirtualMachineInstance, error) {
+		var err error
+		vmi, err = kubevirt.Client().VirtualMachineInstance(vm.Namespace).Get(context.Background(), vm.Name, &metav1.GetOptions{})
+		return vmi, err
+	}, libnet.VMICreationTimeout(), 100*time.Millisecond).Should(matcher.BeInPhase(v1.Running))
+
+	return vm, vmi
+}
+
+// vmiInterfaceMetricValue scrapes the virt-handler serving the VMI and returns the value
+// the given network metric reports for the VMI interface.
+func vmiInterfaceMetricValue(vmi *v1.VirtualMachineInstance, metricName, ifaceName string) (float64, error) {
+	virtClient := kubevirt.Client()
+	handlerPod, err := libnode.GetVirtHandlerPod(virtClient, vmi.Status.NodeName)
+	if err != nil {
+		return 0, err
+	}
+	out := tests.GetKubevirtVMMetricsFunc(&virtClient, handlerPod)(handlerPod.Status.PodIP)
+
+	metrics, err := libinfra.ParseMetricsToMap(libinfra.TakeMetricsWithPrefix(out, metricName))
+	if err != nil {
+		return 0, err
+	}
+	key := libinfra.GetMetricKeyForVmiInterface(libinfra.GetKeysFromMetrics(metrics), vmi.Name, ifaceName)
+	if key == "" {
+		return 0, fmt.Errorf("%s is not reported for interface %s of VMI %s", metricName, ifaceName, vmi.Name)
+	}
+	return metrics[key], nil
+}
+
+// interfaceStatusMACMatchesDomain compares the MAC reported in the VMI status of the given interface
+// with the MAC of the matching interface in the live domain XML.
+func interfaceStatusMACMatchesDomain(vmi *v1.VirtualMachineInstance, ifaceName string) error {
+	vmi, err := kubevirt.Client().VirtualMachineInstance(vmi.Namespace).Get(context.Background(), vmi.Name, &metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, ifaceName)
+	if ifaceStatus == nil || ifaceStatus.MAC == "" {
+		return fmt.Errorf("interface %q MAC is not reported in the VMI status", ifaceName)
+	}
+
+	domainSpec, err := tests.GetRunningVMIDomainSpec(vmi)
+	if err != nil {
+		return err
+	}
+	for _, domainIface := range domainSpec.Devices.Interfaces {
+		if domainIface.Alias == nil || domainIface.Alias.GetName() != ifaceName {
+			continue
+		}
+		if domainIface.MAC == nil {
+			return fmt.Errorf("interface %q has no MAC in the domain", ifaceName)
+		}
+		if !strings.EqualFold(domainIface.MAC.MAC, ifaceStatus.MAC) {
+			return fmt.Errorf("interface %q MAC drifted: status %q, domain %q", ifaceName, ifaceStatus.MAC, domainIface.MAC.MAC)
+		}
+		return nil
+	}
+	return fmt.Errorf("interface %q not found in the domain", ifaceName)
+}
+
+// checkHostDeviceNames checks the given in-pod devices exist, and are named within the kernel interface name limits.
+func checkHostDeviceNames(pod *k8sv1.Pod, names ...string) error {
+	for _, name := range names {
+		if err := virtnetlink.ValidateInterfaceName(name); err != nil {
+			return err
+		}
+		if !podLinkExists(pod, name) {
+			return fmt.Errorf("link %s not found in pod %s", name, pod.Name)
+		}
+	}
+	return nil
+}
+
+func podLinkExists(pod *k8sv1.Pod, linkName string) bool {
+	_, err := exec.ExecuteCommandOnPod(kubevirt.Client(), pod, "compute",
+		[]string{"test", "-e", fmt.Sprintf("/sys/class/net/%s", linkName)})
+	return err == nil
+}
+
+// monitorGuestAgentConnection watches the updates of the VMI, until the returned stop function is called
+// or the spec ends. The stop function returns the updates in which the guest agent was not connected,
+// or did not report the guest OS info.
+func monitorGuestAgentConnection(vmi *v1.VirtualMachineInstance) (stop func() []string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	vmiWatch, err := kubevirt.Client().VirtualMachineInstance(vmi.Namespace).Watch(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", vmi.Name).String(),
+	})
+	if err != nil {
+		cancel()
+	}
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	var disruptions []string
+	stopped := make(chan struct{})
+	go func() {
+		defer GinkgoRecover()
+		defer close(stopped)
+
+		for event := range vmiWatch.ResultChan() {
+			if event.Type == k8sWatch.Error {
+				disruptions = append(disruptions, fmt.Sprintf("watch error: %v", errors.FromObject(event.Object)))
+				continue
+			}
+			updatedVMI, ok := event.Object.(*v1.VirtualMachineInstance)
+			if !ok {
+				continue
+			}
+			if err := guestAgentConnected(updatedVMI); err != nil {
+				disruptions = append(disruptions, fmt.Sprintf("resource version %s: %v", updatedVMI.ResourceVersion, err))
+			}
+		}
+		if ctx.Err() == nil {
+			disruptions = append(disruptions, "the VMI watch was closed before the monitoring was stopped")
+		}
+	}()
+
+	var stopOnce sync.Once
+	stop = func() []string {
+		stopOnce.Do(func() {
+			cancel()
+			vmiWatch.Stop()
+			<-stopped
+		})
+		return disruptions
+	}
+	DeferCleanup(func() { stop() })
+	return stop
+}
+
+func guestAgentConnected(vmi *v1.VirtualMachineInstance) error {
+	if !controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(
+		vmi, v1.VirtualMachineInstanceAgentConnected, k8sv1.ConditionTrue) {
+		return fmt.Errorf("guest agent is not connected")
+	}
+	if vmi.Status.GuestOSInfo.Name == "" {
+		return fmt.Errorf("guest OS info is not reported")
+	}
+	return nil
+}
+
+// lookupPodNetworkStatus returns the network status the pod reports for the given pod interface,
+// or nil if the pod does not report it.
+func lookupPodNetworkStatus(pod *k8sv1.Pod, podIfaceName string) (*k8snetworkplumbingwgv1.NetworkStatus, error) {
+	var networkStatuses []k8snetworkplumbingwgv1.NetworkStatus
+	if err := json.Unmarshal([]byte(pod.Annotations[k8snetworkplumbingwgv1.NetworkStatusAnnot]), &networkStatuses); err != nil {
+		return nil, err
+	}
+	for i := range networkStatuses {
+		if networkStatuses[i].Interface == podIfaceName {
+			return &networkStatuses[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// appendPodNetworkStatus adds the given network status to the ones the pod reports.
+func appendPodNetworkStatus(pod *k8sv1.Pod, networkStatus k8snetworkplumbingwgv1.NetworkStatus) error {
+	var networkStatuses []k8snetworkplumbingwgv1.NetworkStatus
+	if err := json.Unmarshal([]byte(pod.Annotations[k8snetworkplumbingwgv1.NetworkStatusAnnot]), &networkStatuses); err != nil {
+		return err
+	}
+	networkStatusesJSON, err := json.Marshal(append(networkStatuses, networkStatus))
+	if err != nil {
+		return err
+	}
+	patchData, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{k8snetworkplumbingwgv1.NetworkStatusAnnot: string(networkStatusesJSON)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = kubevirt.Client().CoreV1().Pods(pod.Namespace).Patch(context.Background(), pod.Name, types.MergePatchType, patchData, metav1.PatchOptions{})
+	return err
+}
+
+func isPodLinkPromisc(pod *k8sv1.Pod, linkName string) bool {
+	out, err := exec.ExecuteCommandOnPod(kubevirt.Client(), pod, "compute", linkFlagsCommand(linkName))
+	Expect(err).NotTo(HaveOccurred())
+	return hasPromiscFlag(out)
+}
+
+// isNodeLinkPromisc reads the flags of a node link through the virt-handler, which shares the node network namespace.
+func isNodeLinkPromisc(nodeName, linkName string) bool {
+	out, err := tests.ExecuteCommandInVirtHandlerPod(nodeName, linkFlagsCommand(linkName))
+	Expect(err).NotTo(HaveOccurred())
+	return hasPromiscFlag(out)
+}
+
+func linkFlagsCommand(linkName string) []string {
+	return []string{"cat", fmt.Sprintf("/sys/class/net/%s/flags", linkName)}
+}
+
+func hasPromiscFlag(linkFlags string) bool {
+	const iffPromisc = 0x100
+
+	flags, err := strconv.ParseUint(strings.TrimSpace(linkFlags), 0, 32)
+	Expect(err).NotTo(HaveOccurred())
+	return flags&iffPromisc != 0
+}
+
+// checkGuestIfaceRingBuffers checks the current ring buffer sizes of the given interface inside the guest
+func checkGuestIfaceRingBuffers(vmi *v1.VirtualMachineInstance, ifaceName string, rx, tx uint32) error {
+	const currentSettingsSection = "Current hardware settings"
+	cmd := fmt.Sprintf("ethtool -g %s | sed -n '/%s/,$p' | grep -E '^(RX|TX):' | tr -s ' \\t\\n' ' '\n", ifaceName, currentSettingsSection)
+	err := console.SafeExpectBatch(vmi, []expect.Batcher{
+		&expect.BSnd{S: "\n"},
+		&expect.BExp{R: console.PromptExpression},
+		&expect.BSnd{S: cmd},
+		&expect.BExp{R: fmt.Sprintf("RX: %d TX: %d", rx, tx)},
+	}, 15)
+	if err != nil {
+		return fmt.Errorf("ring buffer sizes of interface %s on VMI %s are not rx %d, tx %d: %w", ifaceName, vmi.Name, rx, tx, err)
+	}
+	return nil
+}
+
+const guestIPv6AddressEventsFile = "/tmp/ipv6-address-events"
+
+// startGuestIPv6AddressMonitor records the IPv6 addresses assigned inside the guest from now on, on any interface.
+func startGuestIPv6AddressMonitor(vmi *v1.VirtualMachineInstance) error {
+	return console.SafeExpectBatch(vmi, []expect.Batcher{
+		&expect.BSnd{S: "\n"},
+		&expect.BExp{R: console.PromptExpression},
+		&expect.BSnd{S: fmt.Sprintf("ip -6 monitor address > %s &\n", guestIPv6AddressEventsFile)},
+		&expect.BExp{R: console.PromptExpression},
+	}, 15)
+}
+
+// checkGuestIfaceHasNoIPv6 checks that IPv6 is disabled on the given interface inside the guest
+// and that no IPv6 address, not even a link local one, was assigned to it since the IPv6 address monitor started.
+func checkGuestIfaceHasNoIPv6(vmi *v1.VirtualMachineInstance, ifaceName string) error {
+	cmd := fmt.Sprintf("echo $(cat /proc/sys/net/ipv6/conf/%s/disable_ipv6) $(ip -6 addr show dev %s | grep -c inet6) $(grep -c ': %s ' %s)\n",
+		ifaceName, ifaceName, ifaceName, guestIPv6AddressEventsFile)
+	err := console.SafeExpectBatch(vmi, []expect.Batcher{
+		&expect.BSnd{S: "\n"},
+		&expect.BExp{R: console.PromptExpression},
+		&expect.BSnd{S: cmd},
+		&expect.BExp{R: "1 0 0"},
+	}, 15)
+	if err != nil {
+		return fmt.Errorf("interface %s on VMI %s has IPv6 enabled: %w", ifaceName, vmi.Name, err)
+	}
+	return nil
+}
+
+// checkGuestIfaceRouteOnly checks the given guest interface has a route to the given destination,
+// while it has no global address assigned.
+func checkGuestIfaceRouteOnly(vmi *v1.VirtualMachineInstance, ifaceName, routeDestination string) error {
+	cmd := fmt.Sprintf("echo $(ip -4 route show %s dev %s | wc -l) $(ip -o addr show dev %s scope global | wc -l)\n",
+		routeDestination, ifaceName, ifaceName)
+	err := console.SafeExpectBatch(vmi, []expect.Batcher{
+		&expect.BSnd{S: "\n"},
+		&expect.BExp{R: console.PromptExpression},
+		&expect.BSnd{S: cmd},
+		&expect.BExp{R: "1 0"},
+	}, 15)
+	if err != nil {
+		return fmt.Errorf("interface %s on VMI %s is not configured with only a route to %s: %w", ifaceName, vmi.Name, routeDestination, err)
+	}
+	return nil
+}
+
+// checkGuestIfacesWithMAC checks the guest has the given count of interfaces with the given MAC address.
+func checkGuestIfacesWithMAC(vmi *v1.VirtualMachineInstance, macAddress string, count int) error {
+	cmd := fmt.Sprintf("echo mac-count-$(ip -o link | grep -ci %s)\n", macAddress)
+	err := console.SafeExpectBatch(vmi, []expect.Batcher{
+		&expect.BSnd{S: "\n"},
+		&expect.BExp{R: console.PromptExpression},
+		&expect.BSnd{S: cmd},
+		&expect.BExp{R: fmt.Sprintf("mac-count-%d", count)},
+	}, 15)
+	if err != nil {
+		return fmt.Errorf("VMI %s does not have %d interfaces with MAC %s: %w", vmi.Name, count, macAddress, err)
+	}
+	return nil
+}
+
 func newVMWithOneInterface() *v1.VirtualMachine {
 	vm := tests.NewRandomVirtualMachine(libvmi.NewAlpineWithTestTooling(), true)
 	vm.Spec.Template.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
@@ -486,9 +1737,42 @@ func migrate(vmi *v1.VirtualMachineInstance) {
 	tests.ConfirmVMIPostMigration(kubevirt.Client(), vmi, migrationUID)
 }
 
+// migrateWhilePausedAndResume migrates the VMI, pausing it once the migration is running.
+// The VMI is expected to remain paused after the migration, until it is resumed.
+func migrateWhilePausedAndResume(vmi *v1.VirtualMachineInstance) {
+	By("migrating the VMI")
+	migration := tests.RunMigration(kubevirt.Client(), tests.NewRandomMigration(vmi.Name, vmi.Namespace))
+	getMigrationPhase := func() v1.VirtualMachineInstanceMigrationPhase {
+		migration, err := kubevirt.Client().VirtualMachineInstanceMigration(migration.Namespace).Get(migration.Name, &metav1.GetOptions{})
+		ExpectWithOffset(2, err).NotTo(HaveOccurred())
+		return migration.Status.Phase
+	}
+	EventuallyWithOffset(1, getMigrationPhase, tests.MigrationWaitTime, time.Second).Should(Equal(v1.MigrationRunning))
+
+	By("pausing the VMI during the migration")
+	ExpectWithOffset(1, kubevirt.Client().VirtualMachineInstance(vmi.Namespace).Pause(context.Background(), vmi.Name, &v1.PauseOptions{})).To(Succeed())
+	EventuallyWithOffset(1, matcher.ThisVMI(vmi), libnet.HotplugTimeout(), time.Second).Should(matcher.HaveConditionTrue(v1.VirtualMachineInstancePaused))
+	ExpectWithOffset(1, getMigrationPhase()).To(Equal(v1.MigrationRunning), "the VMI should be paused before the migration completes")
+
+	migration = tests.ExpectMigrationSuccess(kubevirt.Client(), migration, tests.MigrationWaitTime)
+	tests.ConfirmVMIPostMigration(kubevirt.Client(), vmi, migration)
+
+	By("verifying the VMI is still paused after the migration")
+	isPaused, err := tests.LibvirtDomainIsPaused(kubevirt.Client(), vmi)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	ExpectWithOffset(1, isPaused).To(BeTrue(), "the VMI should remain paused after the migration")
+
+	By("resuming the VMI")
+	ExpectWithOffset(1, kubevirt.Client().VirtualMachineInstance(vmi.Namespace).Unpause(context.Background(), vmi.Name, &v1.UnpauseOptions{})).To(Succeed())
+	EventuallyWithOffset(1, matcher.ThisVMI(vmi), libnet.HotplugTimeout(), time.Second).Should(matcher.HaveConditionMissingOrFalse(v1.VirtualMachineInstancePaused))
+}
+
 func addInterface(vm *v1.VirtualMachine, name, netAttachDefName string) error {
 	newNetwork, newIface := newNetworkInterface(name, netAttachDefName)
+	return patchVMWithNewInterface(vm, newNetwork, newIface)
+}
 
+func patchVMWithNewInterface(vm *v1.VirtualMachine, newNetwork v1.Network, newIface v1.Interface) error {
 	patchData, err := patch.GeneratePatchPayload(
 		patch.PatchOperation{
 			Op:    patch.PatchTestOp,
@@ -520,6 +1804,26 @@ func addInterface(vm *v1.VirtualMachine, name, netAttachDefName string) error {
 	return err
 }
 
+// declareDesiredInterfaces sets the given secondary interfaces as the desired ones of the VM,
+// as an external controller managing the VM interfaces would do.
+func declareDesiredInterfaces(vm *v1.VirtualMachine, desiredIfaces []vmispec.DesiredInterface) error {
+	desiredIfacesJSON, err := json.Marshal(desiredIfaces)
+	if err != nil {
+		return err
+	}
+	patchData, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{v1.DesiredInterfacesAnnotation: string(desiredIfacesJSON)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = kubevirt.Client().VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.MergePatchType, patchData, &metav1.PatchOptions{})
+	return err
+}
+
 func newNetworkInterface(name, netAttachDefName string) (v1.Network, v1.Interface) {
 	network := v1.Network{
 		Name: name,
@@ -536,6 +1840,19 @@ func newNetworkInterface(name, netAttachDefName string) (v1.Network, v1.Interfac
 	return network, iface
 }
 
+// plugInterfaceAgain requests the given interface of the VM, which was previously unplugged, to be plugged again
+func plugInterfaceAgain(vm *v1.VirtualMachine, name string) error {
+	specCopy := vm.Spec.Template.Spec.DeepCopy()
+	ifaceToPlug := vmispec.LookupInterfaceByName(specCopy.Domain.Devices.Interfaces, name)
+	ifaceToPlug.State = ""
+	patchData, err := patch.GenerateTestReplacePatch("/spec/template/spec/domain/devices/interfaces", vm.Spec.Template.Spec.Domain.Devices.Interfaces, specCopy.Domain.Devices.Interfaces)
+	if err != nil {
+		return err
+	}
+	_, err = kubevirt.Client().VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchData, &metav1.PatchOptions{})
+	return err
+}
+
 func removeInterface(vm *v1.VirtualMachine, name string) error {
 	specCopy := vm.Spec.Template.Spec.DeepCopy()
 	ifaceToRemove := vmispec.LookupInterfaceByName(specCopy.Domain.Devices.Interfaces, name)